@@ -21,6 +21,8 @@
 //  displayed()
 //  icon()
 //  hide()
+//  show()
+//  visible()
 //  size()
 //  value()
 //  column_widths()
@@ -116,6 +118,14 @@ void go_fltk_Browser_hide_line(Fl_Browser* b, int line) {
 	b->hide(line);
 }
 
+void go_fltk_Browser_show_line(Fl_Browser* b, int line) {
+	b->show(line);
+}
+
+int go_fltk_Browser_visible(Fl_Browser* b, int line) {
+	return b->visible(line);
+}
+
 int go_fltk_Browser_size(Fl_Browser* b) {
 	return b->size();
 }
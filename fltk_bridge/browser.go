@@ -105,24 +105,30 @@ func (b *Browser) SetTopLine(line int) error {
 }
 
 func (b *Browser) Clear() {
+	C.go_fltk_Browser_clear((*C.Fl_Browser)(b.ptr()))
+	b.forgetLines()
+}
+
+// forgetLines drops the data and icons of all lines, once they are gone.
+func (b *Browser) forgetLines() {
 	for k := range b.icons {
 		delete(b.icons, k)
 	}
 	b.dataMap = newBrowserDataMap()
-	C.go_fltk_Browser_clear((*C.Fl_Browser)(b.ptr()))
+	b.setImages(roleIcons)
 }
 
 func (b *Browser) Remove(line int) error {
 	if line < 1 || line > b.Size() {
 		return ErrInvalidLine
 	}
-	delete(b.icons, line)
 
 	// TODO: got the id from C++ is expensive, need a better way to delete go reference
 	id := uintptr(C.go_fltk_Browser_data((*C.Fl_Browser)(b.ptr()), C.int(line)))
 	b.dataMap.unregister(id)
 
 	C.go_fltk_Browser_remove((*C.Fl_Browser)(b.ptr()), C.int(line))
+	b.shiftIcons(line)
 	return nil
 }
 
@@ -146,6 +152,21 @@ func (b *Browser) HideLine(line int) error {
 	return nil
 }
 
+func (b *Browser) ShowLine(line int) error {
+	if line < 1 || line > b.Size() {
+		return ErrInvalidLine
+	}
+
+	C.go_fltk_Browser_show_line((*C.Fl_Browser)(b.ptr()), C.int(line))
+	return nil
+}
+
+// LineVisible reports whether line is shown, i.e. not hidden by HideLine.
+// Unlike Displayed, it does not depend on the line being scrolled into view.
+func (b *Browser) LineVisible(line int) bool {
+	return C.go_fltk_Browser_visible((*C.Fl_Browser)(b.ptr()), C.int(line)) != 0
+}
+
 func (b *Browser) Size() int {
 	return int(C.go_fltk_Browser_size((*C.Fl_Browser)(b.ptr())))
 }
@@ -158,10 +179,36 @@ func (b *Browser) SetIcon(line int, i Image) {
 	if i == nil {
 		delete(b.icons, line)
 		C.go_fltk_Browser_set_icon((*C.Fl_Browser)(b.ptr()), C.int(line), nil)
+		b.holdIcons()
 		return
 	}
 	b.icons[line] = i
 	C.go_fltk_Browser_set_icon((*C.Fl_Browser)(b.ptr()), C.int(line), b.icons[line].getImage().ptr())
+	b.holdIcons()
+}
+
+// shiftIcons forgets the icon of a removed line and moves the icons of the
+// following lines up by one, as the browser does.
+func (b *Browser) shiftIcons(removed int) {
+	icons := make(map[int]Image, len(b.icons))
+	for line, icon := range b.icons {
+		switch {
+		case line < removed:
+			icons[line] = icon
+		case line > removed:
+			icons[line-1] = icon
+		}
+	}
+	b.icons = icons
+	b.holdIcons()
+}
+
+func (b *Browser) holdIcons() {
+	imgs := make([]*image, 0, len(b.icons))
+	for _, icon := range b.icons {
+		imgs = append(imgs, icon.getImage())
+	}
+	b.setImages(roleIcons, imgs...)
 }
 
 func (b *Browser) FormatChar() rune {
@@ -31,6 +31,8 @@ extern "C" {
 	extern char        go_fltk_Browser_column_char(Fl_Browser* b);
 	extern void        go_fltk_Browser_set_column_char(Fl_Browser* b, char c);
 	extern void        go_fltk_Browser_hide_line(Fl_Browser* b, int line);
+	extern void        go_fltk_Browser_show_line(Fl_Browser* b, int line);
+	extern int         go_fltk_Browser_visible(Fl_Browser* b, int line);
 	extern int         go_fltk_Browser_size(Fl_Browser* b);
 	extern Fl_Image*   go_fltk_Browser_icon(Fl_Browser* b, int line);
 	extern void        go_fltk_Browser_set_icon(Fl_Browser* b, int line, Fl_Image *i);
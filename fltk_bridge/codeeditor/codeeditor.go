@@ -0,0 +1,338 @@
+// Package codeeditor provides CodeEditor, a TextEditor set up for source
+// code: line numbers, syntax highlighting, bracket matching, auto-indent,
+// soft tabs, comment toggling, a current-line frame and a column ruler, all
+// configured per Language.
+package codeeditor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+	"github.com/0xYeah/fltk2go/fltk_bridge/highlight"
+)
+
+// bracketScanLimit bounds how far bracket matching looks for the partner of
+// the bracket at the cursor, in bytes.
+const bracketScanLimit = 1 << 16
+
+// CodeEditor is a TextEditor for source code. It owns its text buffer.
+//
+// Enter keeps the indentation of the current line and indents one level
+// deeper after an opening bracket, Tab and Shift+Tab indent and outdent the
+// selected lines and Ctrl+/ toggles comments. The editor uses its own event
+// and draw handlers; replacing them turns bracket matching, the current-line
+// frame and the ruler off.
+type CodeEditor struct {
+	*fltk_bridge.TextEditor
+	buf          *fltk_bridge.TextBuffer
+	modifyHandle fltk_bridge.TextCallbackHandle
+	lang         Language
+	theme        highlight.Theme
+	highlighter  *highlight.Highlighter
+
+	lineNumbers bool
+	// newlines counts the newlines of the buffer, kept up to date as it
+	// changes so that drawing need not count them.
+	newlines int
+	// digits and numberSize are what the line number column was last sized
+	// for, numberWidth is its width in pixels.
+	digits, numberSize, numberWidth int
+
+	currentLine   bool
+	lineColor     fltk_bridge.Color
+	rulerColor    fltk_bridge.Color
+	bracketColor  fltk_bridge.Color
+	mismatchColor fltk_bridge.Color
+}
+
+func NewCodeEditor(x, y, w, h int) *CodeEditor {
+	e := &CodeEditor{
+		TextEditor:    fltk_bridge.NewTextEditor(x, y, w, h),
+		buf:           fltk_bridge.NewTextBuffer(),
+		lineNumbers:   true,
+		currentLine:   true,
+		lineColor:     fltk_bridge.ColorFromRgb(0xd8, 0xd8, 0xd8),
+		rulerColor:    fltk_bridge.ColorFromRgb(0xe0, 0xe0, 0xe0),
+		bracketColor:  fltk_bridge.ColorFromRgb(0x80, 0x80, 0x80),
+		mismatchColor: fltk_bridge.RED,
+	}
+	e.SetBuffer(e.buf)
+	e.buf.DestroyWith(e.TextEditor)
+	e.SetTextFont(fltk_bridge.COURIER)
+	e.SetLinenumberFont(fltk_bridge.COURIER)
+	e.SetLinenumberAlign(fltk_bridge.ALIGN_RIGHT)
+	e.theme = highlight.LightTheme(e.TextSize())
+	e.modifyHandle = e.buf.AddModifyCallback(e.onModify)
+	e.bindKeys()
+	e.SetEventHandler(e.onEvent)
+	e.SetDrawHandler(e.draw)
+	e.SetLanguage(PlainText)
+	return e
+}
+
+// Destroy deletes the editor. Its buffer is freed once the editor is gone,
+// as it is when a parent deletes the editor.
+func (e *CodeEditor) Destroy() {
+	if e.highlighter != nil {
+		e.highlighter.Detach()
+		e.highlighter = nil
+	}
+	e.buf.RemoveModifyCallback(e.modifyHandle)
+	e.TextEditor.Destroy()
+}
+
+// Buffer returns the buffer holding the edited text.
+func (e *CodeEditor) Buffer() *fltk_bridge.TextBuffer { return e.buf }
+
+func (e *CodeEditor) Text() string        { return e.buf.Text() }
+func (e *CodeEditor) SetText(text string) { e.buf.SetText(text) }
+
+// OpenFile loads path and switches to the language registered for its
+// extension.
+func (e *CodeEditor) OpenFile(path string, decode ...fltk_bridge.TextDecoder) error {
+	if err := e.buf.LoadFile(path, decode...); err != nil {
+		return err
+	}
+	e.SetLanguage(ForFile(path))
+	e.SetInsertPosition(0)
+	return nil
+}
+
+func (e *CodeEditor) Language() Language { return e.lang }
+
+// SetLanguage applies the tab, comment, bracket and ruler settings of lang
+// and highlights the text with its lexer.
+func (e *CodeEditor) SetLanguage(lang Language) {
+	if lang.TabWidth <= 0 {
+		lang.TabWidth = 8
+	}
+	e.lang = lang
+	e.buf.SetTabWidth(lang.TabWidth)
+	switch {
+	case lang.Lexer == nil:
+		if e.highlighter != nil {
+			e.highlighter.Detach()
+			e.highlighter = nil
+		}
+	case e.highlighter == nil:
+		e.highlighter = highlight.Attach(&e.TextDisplay, e.buf, lang.Lexer, e.theme)
+		e.highlighter.StyleBuffer().DestroyWith(e.TextEditor)
+	default:
+		e.highlighter.SetLexer(lang.Lexer)
+	}
+	e.Redraw()
+}
+
+func (e *CodeEditor) Theme() highlight.Theme { return e.theme }
+
+// SetTheme sets the colors and fonts used for highlighting. The editor's own
+// background and text colors are left to SetColor and SetTextColor.
+func (e *CodeEditor) SetTheme(theme highlight.Theme) {
+	e.theme = theme
+	if e.highlighter != nil {
+		e.highlighter.SetTheme(theme)
+	}
+	e.Redraw()
+}
+
+// Highlighter returns the highlighter in use, or nil if the language has no
+// lexer.
+func (e *CodeEditor) Highlighter() *highlight.Highlighter { return e.highlighter }
+
+// ShowLineNumbers turns the line number column on or off. It is on by
+// default and grows with the number of lines.
+func (e *CodeEditor) ShowLineNumbers(show bool) {
+	e.lineNumbers = show
+	e.digits, e.numberWidth = 0, 0
+	if !show {
+		e.SetLinenumberWidth(0)
+	}
+	e.Redraw()
+}
+
+// HighlightCurrentLine turns the frame around the cursor line on or off.
+func (e *CodeEditor) HighlightCurrentLine(on bool) {
+	e.currentLine = on
+	e.Redraw()
+}
+
+// SetRuler moves the column ruler of the current language; 0 hides it.
+func (e *CodeEditor) SetRuler(column int) {
+	e.lang.Ruler = column
+	e.Redraw()
+}
+
+// SetDecorationColors sets the colors of the current-line frame, the ruler,
+// the boxes around matching brackets and the box around a bracket without a
+// partner, e.g. to suit a dark theme.
+func (e *CodeEditor) SetDecorationColors(line, ruler, bracket, mismatch fltk_bridge.Color) {
+	e.lineColor, e.rulerColor, e.bracketColor, e.mismatchColor = line, ruler, bracket, mismatch
+	e.Redraw()
+}
+
+// onEvent redraws the whole editor whenever the cursor may move, so that the
+// decorations drawn for the old cursor position do not linger.
+func (e *CodeEditor) onEvent(event fltk_bridge.Event) bool {
+	switch event {
+	case fltk_bridge.PUSH, fltk_bridge.DRAG, fltk_bridge.KEYDOWN, fltk_bridge.UNFOCUS:
+		e.Redraw()
+	}
+	return false
+}
+
+func (e *CodeEditor) draw(base func()) {
+	e.fitLineNumbers()
+	base()
+
+	x, y := e.X()+e.numberWidth+2, e.Y()+2
+	w, h := e.W()-e.numberWidth-4-fltk_bridge.ScrollbarSize(), e.H()-4
+	if w <= 0 || h <= 0 {
+		return
+	}
+	fltk_bridge.PushClip(x, y, w, h)
+	defer fltk_bridge.PopClip()
+
+	lineHeight, charWidth := e.metrics()
+	if e.lang.Ruler > 0 {
+		if first := e.buf.LineStart(e.XYToPosition(x+1, y+1)); e.PositionVisible(first) {
+			left, _ := e.PositionToXY(first)
+			rx := left + e.lang.Ruler*charWidth
+			fltk_bridge.SetDrawColor(e.rulerColor)
+			fltk_bridge.DrawYxLine(rx, y, y+h)
+		}
+	}
+	pos := e.GetInsertPosition()
+	if e.currentLine && e.PositionVisible(pos) {
+		_, ly := e.PositionToXY(pos)
+		fltk_bridge.DrawRectWithColor(x, ly, w, lineHeight, e.lineColor)
+	}
+	if at, match := e.MatchBracket(pos); at >= 0 {
+		color := e.bracketColor
+		if match < 0 {
+			color = e.mismatchColor
+		}
+		e.frameChar(at, lineHeight, charWidth, color)
+		if match >= 0 {
+			e.frameChar(match, lineHeight, charWidth, color)
+		}
+	}
+}
+
+func (e *CodeEditor) onModify(pos, inserted, _, _ int, deletedText string) {
+	e.newlines += e.buf.CountLines(pos, pos+inserted) - strings.Count(deletedText, "\n")
+	e.Redraw()
+}
+
+// fitLineNumbers sizes the line number column for the number of lines.
+func (e *CodeEditor) fitLineNumbers() {
+	if !e.lineNumbers {
+		return
+	}
+	digits := max(2, len(strconv.Itoa(e.newlines+1)))
+	size := e.TextSize()
+	if digits == e.digits && size == e.numberSize {
+		return
+	}
+	e.digits, e.numberSize = digits, size
+	fltk_bridge.SetDrawFont(fltk_bridge.COURIER, size)
+	width, _ := fltk_bridge.MeasureText(strings.Repeat("0", digits), false)
+	e.numberWidth = width + 8
+	e.SetLinenumberSize(size)
+	e.SetLinenumberWidth(e.numberWidth)
+}
+
+// metrics returns the height of a text line, which is that of the tallest
+// font in use, and the width of a character in the text font.
+func (e *CodeEditor) metrics() (lineHeight, charWidth int) {
+	fltk_bridge.SetDrawFont(e.TextFont(), e.TextSize())
+	charWidth, lineHeight = fltk_bridge.MeasureText("0", false)
+	if e.highlighter != nil {
+		for _, style := range e.theme {
+			fltk_bridge.SetDrawFont(style.Font, style.Size)
+			_, h := fltk_bridge.MeasureText("0", false)
+			lineHeight = max(lineHeight, h)
+		}
+	}
+	return lineHeight, charWidth
+}
+
+func (e *CodeEditor) frameChar(pos, lineHeight, charWidth int, color fltk_bridge.Color) {
+	if !e.PositionVisible(pos) {
+		return
+	}
+	x, y := e.PositionToXY(pos)
+	w := charWidth
+	if x2, y2 := e.PositionToXY(pos + 1); y2 == y && x2 > x {
+		w = x2 - x
+	}
+	fltk_bridge.DrawRectWithColor(x, y, w, lineHeight, color)
+}
+
+// MatchBracket finds the bracket next to pos, preferring the one after it,
+// and its partner. at is -1 if there is no bracket next to pos and match is
+// -1 if it has no partner. Brackets in strings and comments are ignored when
+// the text is highlighted.
+func (e *CodeEditor) MatchBracket(pos int) (at, match int) {
+	b := e.buf
+	pairs := e.lang.Brackets
+	at, index := -1, -1
+	for _, p := range []int{pos, pos - 1} {
+		if p < 0 || p >= b.Length() {
+			continue
+		}
+		if c := b.CharAt(p); c < 0x80 && !e.inStringOrComment(p) {
+			if index = strings.IndexByte(pairs, byte(c)); index >= 0 {
+				at = p
+				break
+			}
+		}
+	}
+	if at < 0 {
+		return -1, -1
+	}
+	opener, closer := pairs[index&^1], pairs[index|1]
+	if index%2 == 0 {
+		end := min(b.Length(), at+bracketScanLimit)
+		text := b.GetTextRange(at+1, end)
+		depth := 0
+		for i := 0; i < len(text); i++ {
+			if c := text[i]; (c == opener || c == closer) && !e.inStringOrComment(at+1+i) {
+				if c == opener {
+					depth++
+				} else if depth == 0 {
+					return at, at + 1 + i
+				} else {
+					depth--
+				}
+			}
+		}
+		return at, -1
+	}
+	start := max(0, at-bracketScanLimit)
+	text := b.GetTextRange(start, at)
+	depth := 0
+	for i := len(text) - 1; i >= 0; i-- {
+		if c := text[i]; (c == opener || c == closer) && !e.inStringOrComment(start+i) {
+			if c == closer {
+				depth++
+			} else if depth == 0 {
+				return at, start + i
+			} else {
+				depth--
+			}
+		}
+	}
+	return at, -1
+}
+
+func (e *CodeEditor) inStringOrComment(pos int) bool {
+	if e.highlighter == nil {
+		return false
+	}
+	switch e.highlighter.KindAt(pos) {
+	case highlight.String, highlight.Comment:
+		return true
+	}
+	return false
+}
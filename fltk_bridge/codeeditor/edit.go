@@ -0,0 +1,274 @@
+package codeeditor
+
+import (
+	"strings"
+
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+)
+
+func (e *CodeEditor) bindKeys() {
+	e.AddKeyBinding(fltk_bridge.ENTER_KEY, 0, e.command(e.newline))
+	e.AddKeyBinding(fltk_bridge.TAB, 0, e.command(e.tab))
+	e.AddKeyBinding(fltk_bridge.TAB, fltk_bridge.SHIFT, e.command(func(int) { e.Outdent() }))
+	e.AddKeyBinding(fltk_bridge.BACKSPACE, 0, e.command(e.backspace))
+	e.AddKeyBinding('/', fltk_bridge.CTRL, e.command(func(int) { e.ToggleComment() }))
+}
+
+// command turns fn into a key handler that gets the cursor position and
+// scrolls the cursor into view afterwards.
+func (e *CodeEditor) command(fn func(pos int)) func() bool {
+	return func() bool {
+		fn(e.GetInsertPosition())
+		e.ShowInsertPosition()
+		return true
+	}
+}
+
+// indentUnit is the text one indentation level adds.
+func (e *CodeEditor) indentUnit() string {
+	if e.lang.SoftTabs {
+		return strings.Repeat(" ", e.lang.TabWidth)
+	}
+	return "\t"
+}
+
+// deleteSelection removes the selected text and returns where the cursor
+// ends up, which is pos if nothing was selected.
+func (e *CodeEditor) deleteSelection(pos int) int {
+	if !e.buf.IsSelected() {
+		return pos
+	}
+	start, _ := e.buf.GetSelectionPosition()
+	e.buf.ReplaceSelection("")
+	return start
+}
+
+// newline breaks the line at pos and indents the new line like the current
+// one, one level deeper after an opening bracket. Between a pair of brackets
+// the closing one moves to a line of its own.
+func (e *CodeEditor) newline(pos int) {
+	pos = e.deleteSelection(pos)
+	b := e.buf
+	before := b.GetTextRange(b.LineStart(pos), pos)
+	indent := before[:len(before)-len(strings.TrimLeft(before, " \t"))]
+	text := "\n" + indent
+	cursor := len(text)
+	if trimmed := strings.TrimRight(before, " \t"); trimmed != "" {
+		last := trimmed[len(trimmed)-1]
+		if i := strings.IndexByte(e.lang.Brackets, last); i >= 0 && i%2 == 0 {
+			text += e.indentUnit()
+			cursor = len(text)
+			if pos < b.Length() && b.CharAt(pos) == rune(e.lang.Brackets[i+1]) {
+				text += "\n" + indent
+			}
+		} else if strings.IndexByte(e.lang.IndentAfter, last) >= 0 {
+			text += e.indentUnit()
+			cursor = len(text)
+		}
+	}
+	b.Insert(pos, text)
+	e.SetInsertPosition(pos + cursor)
+}
+
+// tab indents the selected lines if the selection spans several of them, and
+// otherwise inserts a tab or, with soft tabs, spaces up to the next tab stop.
+func (e *CodeEditor) tab(pos int) {
+	b := e.buf
+	if b.IsSelected() {
+		start, end := b.GetSelectionPosition()
+		if b.CountLines(start, end) > 0 {
+			e.Indent()
+			return
+		}
+		pos = e.deleteSelection(pos)
+	}
+	text := "\t"
+	if e.lang.SoftTabs {
+		text = strings.Repeat(" ", e.lang.TabWidth-e.column(pos)%e.lang.TabWidth)
+	}
+	b.Insert(pos, text)
+	e.SetInsertPosition(pos + len(text))
+}
+
+// backspace deletes the selection or the character before pos. With soft
+// tabs, in the indentation of a line, it deletes back to the previous tab
+// stop instead.
+func (e *CodeEditor) backspace(pos int) {
+	b := e.buf
+	if b.IsSelected() {
+		e.SetInsertPosition(e.deleteSelection(pos))
+		return
+	}
+	if pos == 0 {
+		return
+	}
+	var start int
+	if lineStart := b.LineStart(pos); e.lang.SoftTabs && pos > lineStart &&
+		strings.Trim(b.GetTextRange(lineStart, pos), " ") == "" {
+		n := (pos - lineStart) % e.lang.TabWidth
+		if n == 0 {
+			n = e.lang.TabWidth
+		}
+		start = pos - n
+	} else {
+		start = b.PrevChar(pos)
+	}
+	b.Remove(start, pos)
+	e.SetInsertPosition(start)
+}
+
+// column returns the display column of pos, with tabs expanded.
+func (e *CodeEditor) column(pos int) int {
+	col := 0
+	for _, r := range e.buf.GetTextRange(e.buf.LineStart(pos), pos) {
+		if r == '\t' {
+			col += e.lang.TabWidth - col%e.lang.TabWidth
+		} else {
+			col++
+		}
+	}
+	return col
+}
+
+// selectedLines returns the range of the lines touched by the selection, or
+// of the cursor line if nothing is selected. A selection ending at the start
+// of a line does not include that line.
+func (e *CodeEditor) selectedLines() (start, end int, selected bool) {
+	b := e.buf
+	if !b.IsSelected() {
+		pos := e.GetInsertPosition()
+		return b.LineStart(pos), b.LineEnd(pos), false
+	}
+	start, end = b.GetSelectionPosition()
+	if end > start && end == b.LineStart(end) {
+		end--
+	}
+	return b.LineStart(start), b.LineEnd(end), true
+}
+
+// replaceLines replaces the lines from start to end with the result of fn
+// applied to each of them, as a single edit. It keeps whole lines selected if
+// there was a selection, and otherwise moves the cursor with its line.
+func (e *CodeEditor) replaceLines(fn func(lines []string) []string) {
+	start, end, selected := e.selectedLines()
+	old := e.buf.GetTextRange(start, end)
+	text := strings.Join(fn(strings.Split(old, "\n")), "\n")
+	if text == old {
+		return
+	}
+	pos := e.GetInsertPosition()
+	e.buf.ReplaceRange(start, end, text)
+	if selected {
+		e.buf.Select(start, start+len(text))
+		e.SetInsertPosition(start + len(text))
+		return
+	}
+	e.SetInsertPosition(max(start, min(pos+len(text)-len(old), start+len(text))))
+}
+
+// Indent adds one level of indentation to the selected lines, or to the
+// cursor line. Empty lines are left alone.
+func (e *CodeEditor) Indent() {
+	unit := e.indentUnit()
+	e.replaceLines(func(lines []string) []string {
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = unit + line
+			}
+		}
+		return lines
+	})
+}
+
+// Outdent removes one level of indentation, a tab or up to TabWidth spaces,
+// from the selected lines or the cursor line.
+func (e *CodeEditor) Outdent() {
+	width := e.lang.TabWidth
+	e.replaceLines(func(lines []string) []string {
+		for i, line := range lines {
+			if strings.HasPrefix(line, "\t") {
+				lines[i] = line[1:]
+				continue
+			}
+			n := 0
+			for n < len(line) && n < width && line[n] == ' ' {
+				n++
+			}
+			lines[i] = line[n:]
+		}
+		return lines
+	})
+}
+
+// ToggleComment comments out the selected lines, or the cursor line, with the
+// language's line comment, or uncomments them if all of them are comments
+// already. Languages with only block comments get the lines wrapped in one.
+func (e *CodeEditor) ToggleComment() {
+	switch {
+	case e.lang.LineComment != "":
+		e.replaceLines(func(lines []string) []string {
+			return toggleLineComment(lines, e.lang.LineComment)
+		})
+	case e.lang.BlockComment[0] != "":
+		e.replaceLines(func(lines []string) []string {
+			return toggleBlockComment(lines, e.lang.BlockComment[0], e.lang.BlockComment[1])
+		})
+	}
+}
+
+func toggleLineComment(lines []string, prefix string) []string {
+	commented, indent := true, -1
+	for _, line := range lines {
+		body := strings.TrimLeft(line, " \t")
+		if body == "" {
+			continue
+		}
+		if !strings.HasPrefix(body, prefix) {
+			commented = false
+		}
+		if n := len(line) - len(body); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent < 0 {
+		return lines
+	}
+	for i, line := range lines {
+		body := strings.TrimLeft(line, " \t")
+		if body == "" {
+			continue
+		}
+		if commented {
+			body = strings.TrimPrefix(body, prefix)
+			body = strings.TrimPrefix(body, " ")
+			lines[i] = line[:len(line)-len(strings.TrimLeft(line, " \t"))] + body
+		} else {
+			lines[i] = line[:indent] + prefix + " " + line[indent:]
+		}
+	}
+	return lines
+}
+
+func toggleBlockComment(lines []string, opener, closer string) []string {
+	first, last := 0, len(lines)-1
+	for first < last && strings.TrimSpace(lines[first]) == "" {
+		first++
+	}
+	for last > first && strings.TrimSpace(lines[last]) == "" {
+		last--
+	}
+	head, tail := strings.TrimLeft(lines[first], " \t"), strings.TrimRight(lines[last], " \t")
+	if strings.HasPrefix(head, opener) && strings.HasSuffix(tail, closer) &&
+		(first != last || len(head) >= len(opener)+len(closer)) {
+		lines[first] = lines[first][:len(lines[first])-len(head)] + strings.TrimPrefix(strings.TrimPrefix(head, opener), " ")
+		tail = strings.TrimRight(lines[last], " \t")
+		lines[last] = strings.TrimSuffix(strings.TrimSuffix(tail, closer), " ")
+		return lines
+	}
+	if strings.TrimSpace(strings.Join(lines, "")) == "" {
+		return lines
+	}
+	lines[first] = lines[first][:len(lines[first])-len(head)] + opener + " " + head
+	lines[last] = strings.TrimRight(lines[last], " \t") + " " + closer
+	return lines
+}
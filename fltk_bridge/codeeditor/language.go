@@ -0,0 +1,80 @@
+package codeeditor
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/0xYeah/fltk2go/fltk_bridge/highlight"
+)
+
+// Language holds the editing rules a CodeEditor applies to one kind of file.
+type Language struct {
+	Name string
+	// Lexer highlights the text; nil turns highlighting off.
+	Lexer highlight.Lexer
+	// TabWidth is the distance between tab stops in columns.
+	TabWidth int
+	// SoftTabs makes Tab insert spaces up to the next tab stop instead of a
+	// tab character.
+	SoftTabs bool
+	// LineComment starts a comment running to the end of the line, e.g. "//".
+	LineComment string
+	// BlockComment is used to toggle comments when LineComment is empty,
+	// e.g. {"<!--", "-->"}.
+	BlockComment [2]string
+	// Brackets lists the bracket pairs to match, e.g. "()[]{}".
+	Brackets string
+	// IndentAfter lists the characters besides opening brackets that indent
+	// the next line when a line ends in one of them, e.g. ":" for YAML.
+	IndentAfter string
+	// Ruler is the column at which a vertical guide line is drawn, 0 for none.
+	Ruler int
+}
+
+// PlainText is used for files no other language is registered for.
+var PlainText = Language{Name: "text", TabWidth: 8, Brackets: "()[]{}"}
+
+var (
+	languages  = map[string]Language{}
+	extensions = map[string]string{}
+)
+
+// Register makes lang available under its name to Lookup, and to ForFile for
+// file names ending in one of exts (e.g. ".go").
+func Register(lang Language, exts ...string) {
+	languages[lang.Name] = lang
+	for _, ext := range exts {
+		extensions[strings.ToLower(ext)] = lang.Name
+	}
+}
+
+// Lookup returns the language registered under name.
+func Lookup(name string) (Language, bool) {
+	lang, ok := languages[name]
+	return lang, ok
+}
+
+// ForFile returns the language registered for the extension of filename, or
+// PlainText.
+func ForFile(filename string) Language {
+	name, ok := extensions[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		return PlainText
+	}
+	return languages[name]
+}
+
+func init() {
+	Register(Language{Name: "go", Lexer: highlight.Go, TabWidth: 4, LineComment: "//",
+		Brackets: "()[]{}", Ruler: 100}, ".go")
+	Register(Language{Name: "json", Lexer: highlight.JSON, TabWidth: 2, SoftTabs: true,
+		Brackets: "[]{}"}, ".json")
+	Register(Language{Name: "yaml", Lexer: highlight.YAML, TabWidth: 2, SoftTabs: true,
+		LineComment: "#", Brackets: "[]{}", IndentAfter: ":"}, ".yaml", ".yml")
+	Register(Language{Name: "ini", Lexer: highlight.INI, TabWidth: 4, SoftTabs: true,
+		LineComment: ";", Brackets: "[]"}, ".ini", ".cfg", ".conf")
+	Register(Language{Name: "shell", Lexer: highlight.Shell, TabWidth: 4, SoftTabs: true,
+		LineComment: "#", Brackets: "()[]{}", Ruler: 80}, ".sh", ".bash", ".zsh")
+	Register(Language{Name: "markdown", Lexer: highlight.Markdown, TabWidth: 4, SoftTabs: true,
+		BlockComment: [2]string{"<!--", "-->"}, Brackets: "()[]", Ruler: 80}, ".md", ".markdown")
+}
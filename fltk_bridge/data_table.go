@@ -0,0 +1,369 @@
+package fltk_bridge
+
+/*
+#include "table.h"
+*/
+import "C"
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"time"
+	"unsafe"
+)
+
+// TableModel supplies the data shown by a DataTable. Rows and columns are
+// counted from 0 in the model's own order, whatever the table shows.
+type TableModel interface {
+	RowCount() int
+	ColumnCount() int
+	Value(row, col int) any
+	ColumnSpec(col int) ColumnSpec
+}
+
+// ColumnSpec describes a column of a DataTable.
+type ColumnSpec struct {
+	Title string
+	// Width is the width of the column, or 0 to keep the table's default.
+	Width int
+	// Align places the text in the cells, ALIGN_LEFT if 0.
+	Align Align
+	// Format turns a value into the text shown for it. Values are shown
+	// with fmt.Sprint if it is nil.
+	Format func(value any) string
+	// Compare orders values for sorting. If it is nil, numbers, strings,
+	// bools and times are compared by value and anything else by the text
+	// fmt.Sprint gives.
+	Compare func(a, b any) int
+}
+
+// DataTable is a TableRow that shows the rows of a TableModel, drawing the
+// column headers and cells itself. Clicking a column header sorts the rows
+// by that column, ascending and then descending, and an arrow in the header
+// shows the order. A filter hides rows without copying the model: the table
+// only keeps which model row each of its rows shows. Ctrl+C copies the
+// selected rows, as shown, to the clipboard.
+//
+// Rows passed to and returned by the methods of a DataTable are model rows
+// unless they are said to be view rows, which are counted as shown.
+//
+// The table uses its draw cell callback and its event handler; replacing
+// them stops it from drawing the model and sorting.
+type DataTable struct {
+	TableRow
+	model  TableModel
+	filter func(row int) bool
+	// view holds the model row shown by each view row.
+	view       []int
+	sortColumn int
+	descending bool
+	// pushedHeader is the column header the mouse was pushed on, or -1.
+	pushedHeader int
+}
+
+func NewDataTable(x, y, w, h int, model TableModel) *DataTable {
+	t := &DataTable{sortColumn: -1, pushedHeader: -1}
+	initWidget(t, unsafe.Pointer(C.go_fltk_new_TableRow(C.int(x), C.int(y), C.int(w), C.int(h))))
+	t.deletionHandlerId = t.addDeletionHandler(t.onDelete)
+	t.EnableColumnHeaders()
+	t.AllowColumnResizing()
+	t.SetDrawCellCallback(t.drawCell)
+	t.SetEventHandler(t.onEvent)
+	t.SetModel(model)
+	return t
+}
+
+func (t *DataTable) Model() TableModel { return t.model }
+
+// SetModel shows model, sorted by the current sort column if it has one,
+// with no rows selected.
+func (t *DataTable) SetModel(model TableModel) {
+	t.model, t.view = model, nil
+	if t.sortColumn >= model.ColumnCount() {
+		t.sortColumn = -1
+	}
+	t.SetColumnCount(model.ColumnCount())
+	for col := 0; col < model.ColumnCount(); col++ {
+		if width := model.ColumnSpec(col).Width; width > 0 {
+			t.SetColumnWidth(col, width)
+		}
+	}
+	t.Reload()
+}
+
+// Reload reads the rows of the model again after they changed, applying
+// the filter and the sort order, and keeps the selected rows that remain
+// selected.
+func (t *DataTable) Reload() {
+	selected := t.SelectedRows()
+	t.view = t.view[:0]
+	for row := 0; row < t.model.RowCount(); row++ {
+		if t.filter == nil || t.filter(row) {
+			t.view = append(t.view, row)
+		}
+	}
+	t.sortView()
+	t.SetRowCount(len(t.view))
+	t.SelectAllRows(Deselect)
+	t.selectRows(selected)
+	t.Redraw()
+}
+
+// SetFilter shows only the model rows for which keep returns true, or all
+// rows if keep is nil.
+func (t *DataTable) SetFilter(keep func(row int) bool) {
+	t.filter = keep
+	t.Reload()
+}
+
+// SortBy sorts the rows by column col, in descending order if descending
+// is set. Rows with equal values keep their model order.
+func (t *DataTable) SortBy(col int, descending bool) {
+	if col < 0 || col >= t.model.ColumnCount() {
+		return
+	}
+	t.sortColumn, t.descending = col, descending
+	t.Reload()
+}
+
+// ClearSort shows the rows in model order again.
+func (t *DataTable) ClearSort() {
+	t.sortColumn = -1
+	t.Reload()
+}
+
+// SortColumn returns the column the rows are sorted by, or -1, and whether
+// the order is descending.
+func (t *DataTable) SortColumn() (col int, descending bool) {
+	return t.sortColumn, t.descending
+}
+
+// ModelRow returns the model row shown by view row, or -1.
+func (t *DataTable) ModelRow(viewRow int) int {
+	if viewRow < 0 || viewRow >= len(t.view) {
+		return -1
+	}
+	return t.view[viewRow]
+}
+
+// ViewRow returns the view row showing model row, if it is not filtered
+// out.
+func (t *DataTable) ViewRow(row int) (int, bool) {
+	i := slices.Index(t.view, row)
+	return i, i >= 0
+}
+
+// SelectedRows returns the selected model rows in view order.
+func (t *DataTable) SelectedRows() []int {
+	var rows []int
+	for i, row := range t.view {
+		if t.IsRowSelected(i) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// Text returns the text shown for the value at model row, col.
+func (t *DataTable) Text(row, col int) string {
+	value := t.model.Value(row, col)
+	if format := t.model.ColumnSpec(col).Format; format != nil {
+		return format(value)
+	}
+	return fmt.Sprint(value)
+}
+
+// SelectionTSV returns the selected rows as shown, in view order, as
+// tab-separated values.
+func (t *DataTable) SelectionTSV() string {
+	return t.TableRow.SelectionTSV(t.viewText)
+}
+
+// CopySelection copies the selected rows as shown to the clipboard and
+// reports whether any were selected.
+func (t *DataTable) CopySelection() bool {
+	return t.TableRow.CopySelection(t.viewText)
+}
+
+// WriteCSV writes the rows as shown, filtered, sorted and formatted, to w as
+// CSV, with the column titles first.
+func (t *DataTable) WriteCSV(w io.Writer) error {
+	header := make([]string, t.model.ColumnCount())
+	for col := range header {
+		header[col] = t.model.ColumnSpec(col).Title
+	}
+	return WriteTableCSV(w, header, len(t.view), len(header), t.viewText)
+}
+
+func (t *DataTable) viewText(viewRow, col int) string {
+	return t.Text(t.view[viewRow], col)
+}
+
+func (t *DataTable) sortView() {
+	if t.sortColumn < 0 {
+		return
+	}
+	col := t.sortColumn
+	compare := t.model.ColumnSpec(col).Compare
+	if compare == nil {
+		compare = compareCellValues
+	}
+	slices.SortStableFunc(t.view, func(a, b int) int {
+		c := compare(t.model.Value(a, col), t.model.Value(b, col))
+		if t.descending {
+			return -c
+		}
+		return c
+	})
+}
+
+func (t *DataTable) selectRows(rows []int) {
+	if len(rows) == 0 {
+		return
+	}
+	shown := make(map[int]int, len(t.view))
+	for i, row := range t.view {
+		shown[row] = i
+	}
+	for _, row := range rows {
+		if i, ok := shown[row]; ok {
+			t.SelectRow(i, Select)
+		}
+	}
+}
+
+// SetCellEventHandler sets an event handler that is passed the cell the
+// event is about, as TableRow's is, and runs before the table's own
+// handling, which sorts and copies, unless it returns true.
+func (t *DataTable) SetCellEventHandler(handler func(event Event, ctx TableContext, row, col int) bool) {
+	t.SetEventHandler(cellEventHandler(func(event Event, ctx TableContext, row, col int) bool {
+		return handler(event, ctx, row, col) || t.onEvent(event)
+	}, t.Cursor, t.CellFromCursor))
+}
+
+func (t *DataTable) onEvent(event Event) bool {
+	if IsCopyKey(event) {
+		return t.CopySelection()
+	}
+	switch event {
+	case PUSH:
+		t.pushedHeader = -1
+		// a push on a column border starts resizing the column, not sorting
+		if row, col := t.RowAndColumnFromCursor(); row == -1 && col >= 0 && EventButton() == LeftMouse && !t.onResizeBorder() {
+			t.pushedHeader = col
+		}
+	case RELEASE:
+		pushed := t.pushedHeader
+		t.pushedHeader = -1
+		if row, col := t.RowAndColumnFromCursor(); row == -1 && col >= 0 && col == pushed {
+			t.SortBy(col, col == t.sortColumn && !t.descending)
+		}
+	}
+	return false
+}
+
+func (t *DataTable) drawCell(ctx TableContext, row, col, x, y, w, h int) {
+	switch ctx {
+	case ContextStartPage:
+		SetDrawFont(t.LabelFont(), t.LabelSize())
+	case ContextColHeader:
+		spec := t.model.ColumnSpec(col)
+		PushClip(x, y, w, h)
+		DrawBox(THIN_UP_BOX, x, y, w, h, t.Color())
+		SetDrawColor(t.LabelColor())
+		const arrow = 10
+		textWidth := w - 8
+		if col == t.sortColumn {
+			textWidth -= arrow + 4
+			orient := ORIENT_UP
+			if t.descending {
+				orient = ORIENT_DOWN
+			}
+			DrawArrow(x+w-arrow-4, y+(h-arrow)/2, arrow, arrow, ARROW_SINGLE, orient, t.LabelColor())
+		}
+		Draw(spec.Title, x+4, y, textWidth, h, cellAlign(spec.Align))
+		PopClip()
+	case ContextRowHeader:
+		PushClip(x, y, w, h)
+		DrawBox(THIN_UP_BOX, x, y, w, h, t.Color())
+		SetDrawColor(t.LabelColor())
+		Draw(fmt.Sprint(t.ModelRow(row)+1), x+4, y, w-8, h, ALIGN_RIGHT)
+		PopClip()
+	case ContextCell:
+		if row >= len(t.view) {
+			return
+		}
+		PushClip(x, y, w, h)
+		background, foreground := BACKGROUND2_COLOR, FOREGROUND_COLOR
+		if t.IsRowSelected(row) {
+			background, foreground = t.SelectionColor(), WHITE
+		}
+		DrawRectfWithColor(x, y, w, h, background)
+		SetDrawColor(foreground)
+		Draw(t.viewText(row, col), x+4, y, w-8, h, cellAlign(t.model.ColumnSpec(col).Align))
+		PopClip()
+	}
+}
+
+// cellAlign returns align for text in a cell, defaulting to the left.
+func cellAlign(align Align) Align {
+	if align == 0 {
+		align = ALIGN_LEFT
+	}
+	return align | ALIGN_CLIP
+}
+
+// compareCellValues orders a and b by value if they are numbers, strings,
+// bools or times, and otherwise by the text fmt.Sprint gives. nil comes
+// first.
+func compareCellValues(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			return ta.Compare(tb)
+		}
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case va.CanInt() && vb.CanInt():
+		return cmp.Compare(va.Int(), vb.Int())
+	case va.CanUint() && vb.CanUint():
+		return cmp.Compare(va.Uint(), vb.Uint())
+	case isNumber(va) && isNumber(vb):
+		return cmp.Compare(numberValue(va), numberValue(vb))
+	case va.Kind() == reflect.String && vb.Kind() == reflect.String:
+		return cmp.Compare(va.String(), vb.String())
+	case va.Kind() == reflect.Bool && vb.Kind() == reflect.Bool:
+		switch {
+		case va.Bool() == vb.Bool():
+			return 0
+		case vb.Bool():
+			return -1
+		}
+		return 1
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func isNumber(v reflect.Value) bool {
+	return v.CanInt() || v.CanUint() || v.CanFloat()
+}
+
+func numberValue(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
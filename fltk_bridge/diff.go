@@ -0,0 +1,125 @@
+package fltk_bridge
+
+// DiffHunk is a run of differing lines: lines [AStart, AEnd) of the old text
+// were replaced by lines [BStart, BEnd) of the new text. Lines are counted
+// from 0. The old range is empty for an insertion and the new one for a
+// deletion.
+type DiffHunk struct {
+	AStart, AEnd int
+	BStart, BEnd int
+}
+
+// Inserted reports whether the hunk only adds lines.
+func (h DiffHunk) Inserted() bool { return h.AStart == h.AEnd }
+
+// Deleted reports whether the hunk only removes lines.
+func (h DiffHunk) Deleted() bool { return h.BStart == h.BEnd }
+
+// DiffLines compares a and b line by line with the Myers algorithm and
+// returns the hunks of a shortest edit script turning a into b.
+func DiffLines(a, b []string) []DiffHunk {
+	// Common prefixes and suffixes are not part of any edit and cost the
+	// algorithm nothing to skip.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	deleted, inserted := myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+
+	var hunks []DiffHunk
+	i, j := 0, 0
+	for i < len(deleted) || j < len(inserted) {
+		if i < len(deleted) && j < len(inserted) && !deleted[i] && !inserted[j] {
+			i++
+			j++
+			continue
+		}
+		h := DiffHunk{AStart: prefix + i, BStart: prefix + j}
+		for (i < len(deleted) && deleted[i]) || (j < len(inserted) && inserted[j]) {
+			for i < len(deleted) && deleted[i] {
+				i++
+			}
+			for j < len(inserted) && inserted[j] {
+				j++
+			}
+		}
+		h.AEnd, h.BEnd = prefix+i, prefix+j
+		hunks = append(hunks, h)
+	}
+	return hunks
+}
+
+// myersDiff marks the lines of a that are deleted and the lines of b that
+// are inserted by a shortest edit script.
+func myersDiff(a, b []string) (deleted, inserted []bool) {
+	n, m := len(a), len(b)
+	deleted, inserted = make([]bool, n), make([]bool, m)
+	if n == 0 || m == 0 {
+		for i := range deleted {
+			deleted[i] = true
+		}
+		for j := range inserted {
+			inserted[j] = true
+		}
+		return deleted, inserted
+	}
+
+	// v[offset+k] is the furthest x reached on diagonal k = x-y. trace[d]
+	// keeps v[-d..d] as it was before step d, for backtracking.
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	var trace [][]int
+	var d int
+search:
+	for d = 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	x, y := n, m
+	for ; d > 0; d-- {
+		prev := trace[d]
+		at := func(k int) int { return prev[k+d] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+		}
+		if x == prevX {
+			inserted[prevY] = true
+		} else {
+			deleted[prevX] = true
+		}
+		x, y = prevX, prevY
+	}
+	return deleted, inserted
+}
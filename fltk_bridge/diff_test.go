@@ -0,0 +1,51 @@
+package fltk_bridge
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	lines := func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return strings.Split(s, " ")
+	}
+	tests := []struct {
+		name string
+		a, b string
+		want []DiffHunk
+	}{
+		{"both empty", "", "", nil},
+		{"equal", "a b c", "a b c", nil},
+		{"old empty", "", "x y", []DiffHunk{{0, 0, 0, 2}}},
+		{"new empty", "x y", "", []DiffHunk{{0, 2, 0, 0}}},
+		{"insert", "a b c", "a x b c", []DiffHunk{{1, 1, 1, 2}}},
+		{"insert at end", "a b", "a b x y", []DiffHunk{{2, 2, 2, 4}}},
+		{"delete", "a b c d", "a d", []DiffHunk{{1, 3, 1, 1}}},
+		{"delete at start", "x a b", "a b", []DiffHunk{{0, 1, 0, 0}}},
+		{"replace", "a b c", "a x c", []DiffHunk{{1, 2, 1, 2}}},
+		{"replace with more", "a b c", "a x y z c", []DiffHunk{{1, 2, 1, 4}}},
+		{"two hunks", "a b c d e", "a c d x e", []DiffHunk{{1, 2, 1, 1}, {4, 4, 3, 4}}},
+	}
+	for _, tt := range tests {
+		got := DiffLines(lines(tt.a), lines(tt.b))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: DiffLines(%q, %q) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDiffHunkKind(t *testing.T) {
+	if h := (DiffHunk{2, 2, 2, 3}); !h.Inserted() || h.Deleted() {
+		t.Errorf("%v: Inserted %v, Deleted %v, want an insertion", h, h.Inserted(), h.Deleted())
+	}
+	if h := (DiffHunk{2, 3, 2, 2}); h.Inserted() || !h.Deleted() {
+		t.Errorf("%v: Inserted %v, Deleted %v, want a deletion", h, h.Inserted(), h.Deleted())
+	}
+	if h := (DiffHunk{2, 3, 2, 3}); h.Inserted() || h.Deleted() {
+		t.Errorf("%v: Inserted %v, Deleted %v, want a replacement", h, h.Inserted(), h.Deleted())
+	}
+}
@@ -0,0 +1,299 @@
+package fltk_bridge
+
+/*
+#include "group.h"
+*/
+import "C"
+import (
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Style characters of the style buffers of a DiffView.
+const (
+	diffPlain    = 'A'
+	diffDeleted  = 'B'
+	diffInserted = 'C'
+	diffChanged  = 'D'
+)
+
+// DiffView shows two texts side by side, the old one on the left, with
+// deleted, inserted and changed lines colored and marked in the gutter. The
+// two sides scroll together, keeping corresponding lines level. The view
+// owns its buffers, which are freed when it is deleted.
+type DiffView struct {
+	Group
+	left, right           *TextDisplay
+	leftText, rightText   *TextBuffer
+	leftStyle, rightStyle *TextBuffer
+	hunks                 []DiffHunk
+	current               int
+	// leftRow and rightRow are the top lines the sides were last synced at.
+	leftRow, rightRow int
+	// numberDigits is what the line number columns were last sized for.
+	numberDigits int
+	// markerColors are the gutter colors of deleted, inserted and changed
+	// lines.
+	markerColors [3]Color
+}
+
+func NewDiffView(x, y, w, h int) *DiffView {
+	d := &DiffView{
+		leftText:   NewTextBuffer(),
+		rightText:  NewTextBuffer(),
+		leftStyle:  NewTextBuffer(),
+		rightStyle: NewTextBuffer(),
+		current:    -1,
+		markerColors: [3]Color{
+			ColorFromRgb(0xd0, 0x30, 0x30),
+			ColorFromRgb(0x30, 0xa0, 0x30),
+			ColorFromRgb(0xd0, 0x90, 0x00),
+		},
+	}
+	initWidget(d, unsafe.Pointer(C.go_fltk_new_Group(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+	d.Begin()
+	d.left = NewTextDisplay(x, y, w/2, h)
+	d.right = NewTextDisplay(x+w/2, y, w-w/2, h)
+	d.End()
+	for _, buf := range []*TextBuffer{d.leftText, d.rightText, d.leftStyle, d.rightStyle} {
+		buf.DestroyWith(d)
+	}
+
+	for _, side := range []struct {
+		display     *TextDisplay
+		text, style *TextBuffer
+	}{{d.left, d.leftText, d.leftStyle}, {d.right, d.rightText, d.rightStyle}} {
+		side.display.SetBuffer(side.text)
+		side.display.SetTextFont(COURIER)
+		side.display.SetLinenumberFont(COURIER)
+		side.display.SetLinenumberAlign(ALIGN_RIGHT)
+	}
+	d.SetTheme(
+		ColorFromRgb(0xff, 0xdc, 0xdc),
+		ColorFromRgb(0xdc, 0xff, 0xdc),
+		ColorFromRgb(0xff, 0xf2, 0xc8),
+	)
+	d.left.SetDrawHandler(func(base func()) { d.drawSide(base, true) })
+	d.right.SetDrawHandler(func(base func()) { d.drawSide(base, false) })
+	return d
+}
+
+// Left and Right return the displays of the old and the new text, e.g. to
+// change their fonts.
+func (d *DiffView) Left() *TextDisplay  { return d.left }
+func (d *DiffView) Right() *TextDisplay { return d.right }
+
+// SetTheme sets the background colors of deleted, inserted and changed
+// lines.
+func (d *DiffView) SetTheme(deleted, inserted, changed Color) {
+	for _, side := range []struct {
+		display *TextDisplay
+		style   *TextBuffer
+	}{{d.left, d.leftStyle}, {d.right, d.rightStyle}} {
+		plain := StyleTableEntry{Color: side.display.TextColor(), Font: side.display.TextFont(), Size: side.display.TextSize()}
+		entries := make([]StyleTableEntry, 4)
+		for i, bg := range []Color{0, deleted, inserted, changed} {
+			entries[i] = plain
+			if i > 0 {
+				entries[i].Attr, entries[i].BgColor = ATTR_BGCOLOR_EXT, bg
+			}
+		}
+		side.display.SetHighlightData(side.style, entries)
+		side.display.Redraw()
+	}
+}
+
+// SetMarkerColors sets the gutter colors of deleted, inserted and changed
+// lines.
+func (d *DiffView) SetMarkerColors(deleted, inserted, changed Color) {
+	d.markerColors = [3]Color{deleted, inserted, changed}
+	d.left.Redraw()
+	d.right.Redraw()
+}
+
+// SetTexts compares oldText and newText and shows the result.
+func (d *DiffView) SetTexts(oldText, newText string) {
+	a, b := strings.Split(oldText, "\n"), strings.Split(newText, "\n")
+	d.hunks = DiffLines(a, b)
+	d.current = -1
+	leftStyle, rightStyle := []byte(oldText), []byte(newText)
+	for i := range leftStyle {
+		leftStyle[i] = diffPlain
+	}
+	for i := range rightStyle {
+		rightStyle[i] = diffPlain
+	}
+	aStarts, bStarts := lineOffsets(a), lineOffsets(b)
+	for _, h := range d.hunks {
+		del, ins := byte(diffChanged), byte(diffChanged)
+		if h.Inserted() || h.Deleted() {
+			del, ins = diffDeleted, diffInserted
+		}
+		fillStyle(leftStyle, aStarts, h.AStart, h.AEnd, del)
+		fillStyle(rightStyle, bStarts, h.BStart, h.BEnd, ins)
+	}
+	d.leftStyle.SetText(string(leftStyle))
+	d.rightStyle.SetText(string(rightStyle))
+	d.leftText.SetText(oldText)
+	d.rightText.SetText(newText)
+	d.left.Scroll(1, 0)
+	d.right.Scroll(1, 0)
+	d.leftRow, d.rightRow = 1, 1
+	d.numberDigits = 0
+	d.Redraw()
+}
+
+// lineOffsets returns the byte offset of the start of each line and, last,
+// the length of the text plus one.
+func lineOffsets(lines []string) []int {
+	offsets := make([]int, len(lines)+1)
+	for i, line := range lines {
+		offsets[i+1] = offsets[i] + len(line) + 1
+	}
+	return offsets
+}
+
+// fillStyle sets the style of lines [start, end), including their line
+// breaks, to c.
+func fillStyle(style []byte, offsets []int, start, end int, c byte) {
+	for i := offsets[start]; i < min(offsets[end], len(style)); i++ {
+		style[i] = c
+	}
+}
+
+// Hunks returns the differences found by SetTexts.
+func (d *DiffView) Hunks() []DiffHunk { return d.hunks }
+
+// CurrentHunk returns the index of the hunk last moved to, or -1.
+func (d *DiffView) CurrentHunk() int { return d.current }
+
+// NextHunk scrolls to the next hunk and reports whether there was one.
+func (d *DiffView) NextHunk() bool {
+	if d.current+1 >= len(d.hunks) {
+		return false
+	}
+	d.ShowHunk(d.current + 1)
+	return true
+}
+
+// PreviousHunk scrolls to the previous hunk and reports whether there was
+// one.
+func (d *DiffView) PreviousHunk() bool {
+	if d.current <= 0 {
+		return false
+	}
+	d.ShowHunk(d.current - 1)
+	return true
+}
+
+// ShowHunk scrolls both sides to hunk i, with a few lines of context above.
+func (d *DiffView) ShowHunk(i int) {
+	if i < 0 || i >= len(d.hunks) {
+		return
+	}
+	const context = 3
+	d.current = i
+	h := d.hunks[i]
+	d.left.Scroll(max(1, h.AStart+1-context), d.left.ScrollCol())
+	d.right.Scroll(max(1, h.BStart+1-context), d.right.ScrollCol())
+	d.leftRow, d.rightRow = d.left.ScrollRow(), d.right.ScrollRow()
+}
+
+// mapLine returns the line on the other side that corresponds to line,
+// counted from 0, of the left side if fromLeft is set or else of the right.
+func (d *DiffView) mapLine(line int, fromLeft bool) int {
+	delta := 0
+	for _, h := range d.hunks {
+		start, end, otherStart, otherEnd := h.BStart, h.BEnd, h.AStart, h.AEnd
+		if fromLeft {
+			start, end, otherStart, otherEnd = h.AStart, h.AEnd, h.BStart, h.BEnd
+		}
+		if line < start {
+			break
+		}
+		if line < end {
+			return otherStart + min(line-start, max(otherEnd-otherStart-1, 0))
+		}
+		delta = otherEnd - end
+	}
+	return line + delta
+}
+
+// drawSide draws one side, brings the other side level with it if it was
+// scrolled, and draws the gutter markers.
+func (d *DiffView) drawSide(base func(), isLeft bool) {
+	d.fitLineNumbers()
+	base()
+
+	display, other, row, otherRow := d.left, d.right, &d.leftRow, &d.rightRow
+	text := d.leftText
+	if !isLeft {
+		display, other, row, otherRow = d.right, d.left, &d.rightRow, &d.leftRow
+		text = d.rightText
+	}
+	if top := display.ScrollRow(); top != *row {
+		*row = top
+		target := d.mapLine(top-1, isLeft) + 1
+		if target != other.ScrollRow() {
+			other.Scroll(target, other.ScrollCol())
+		}
+		*otherRow = other.ScrollRow()
+	}
+
+	SetDrawFont(display.TextFont(), display.TextSize())
+	_, lineHeight := MeasureText("0", false)
+	first := display.ScrollRow() - 1
+	last := first + display.H()/max(lineHeight, 1) + 1
+	firstPos := text.SkipLines(0, first)
+	x := display.X() + 2
+	PushClip(x, display.Y()+2, 3, display.H()-4)
+	defer PopClip()
+	for _, h := range d.hunks {
+		start, end := h.AStart, h.AEnd
+		if !isLeft {
+			start, end = h.BStart, h.BEnd
+		}
+		if end < first || start > last {
+			continue
+		}
+		color := d.markerColors[2]
+		switch {
+		case h.Deleted():
+			color = d.markerColors[0]
+		case h.Inserted():
+			color = d.markerColors[1]
+		}
+		if start == end {
+			// The lines only exist on the other side: mark where they go.
+			if pos := text.SkipLines(firstPos, start-first); display.PositionVisible(pos) {
+				_, y := display.PositionToXY(pos)
+				DrawRectfWithColor(x, y-1, 3, 3, color)
+			}
+			continue
+		}
+		for line := max(start, first); line < min(end, last+1); line++ {
+			if pos := text.SkipLines(firstPos, line-first); display.PositionVisible(pos) {
+				_, y := display.PositionToXY(pos)
+				DrawRectfWithColor(x, y, 3, lineHeight, color)
+			}
+		}
+	}
+}
+
+// fitLineNumbers sizes the line number columns of both sides for the longer
+// text.
+func (d *DiffView) fitLineNumbers() {
+	lines := max(d.leftText.CountLines(0, d.leftText.Length()), d.rightText.CountLines(0, d.rightText.Length())) + 1
+	digits := max(2, len(strconv.Itoa(lines)))
+	if digits == d.numberDigits {
+		return
+	}
+	d.numberDigits = digits
+	for _, display := range []*TextDisplay{d.left, d.right} {
+		SetDrawFont(COURIER, display.TextSize())
+		width, _ := MeasureText(strings.Repeat("0", digits), false)
+		display.SetLinenumberSize(display.TextSize())
+		display.SetLinenumberWidth(width + 10)
+	}
+}
@@ -4,6 +4,8 @@
 #include <FL/platform.H>
 #include <FL/Enumerations.H>
 
+#include "_cgo_export.h"
+
 
 void go_fltk_color(unsigned int color) {
   fl_color((Fl_Color)color);
@@ -356,6 +358,18 @@ void go_fltk_draw_image_mono(const unsigned char *buf, int X, int Y, int W, int
     fl_draw_image_mono(buf, X, Y, W, H, D, L);
 }
 
+static void draw_image_line_handler(void *data, int x, int y, int w, unsigned char *buf) {
+    _go_drawImageLineHandler((uintptr_t)data, x, y, w, buf);
+}
+
+void go_fltk_draw_image_cb(uintptr_t id, int X, int Y, int W, int H, int D) {
+    fl_draw_image(draw_image_line_handler, (void *)id, X, Y, W, H, D);
+}
+
+void go_fltk_draw_image_mono_cb(uintptr_t id, int X, int Y, int W, int H, int D) {
+    fl_draw_image_mono(draw_image_line_handler, (void *)id, X, Y, W, H, D);
+}
+
 char go_fltk_can_do_alpha_blending(void) {
     return fl_can_do_alpha_blending();
 }
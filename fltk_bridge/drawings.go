@@ -5,7 +5,11 @@ package fltk_bridge
 #include "drawings.h"
 */
 import "C"
-import "unsafe"
+import (
+	"errors"
+	goimage "image"
+	"unsafe"
+)
 
 func SetDrawColor(color Color) {
 	C.go_fltk_color(C.uint(color))
@@ -187,6 +191,131 @@ func DrawCheck(x, y, w, h int, col Color) {
 	C.go_fltk_draw_check(C.int(x), C.int(y), C.int(w), C.int(h), C.uint(col))
 }
 
+// ErrInvalidImageLayout is returned for pixel buffers with a depth other
+// than 1 to 4 bytes or a negative row length.
+var ErrInvalidImageLayout = errors.New("invalid image depth or row length")
+
+// imageBufferLen returns how many bytes of a buffer of w by h pixels of d
+// bytes, with rows ld bytes apart, are read.
+func imageBufferLen(w, h, d, ld int) (int, error) {
+	if d < 1 || d > 4 || ld < 0 {
+		return 0, ErrInvalidImageLayout
+	}
+	if w <= 0 || h <= 0 {
+		return 0, nil
+	}
+	if ld == 0 {
+		ld = w * d
+	}
+	return (h-1)*ld + w*d, nil
+}
+
+// DrawImage draws the pixels in buf directly at x, y without creating an
+// image object. d is the number of bytes per pixel, from 1 to 4 (3 for RGB,
+// 4 for RGBA), and ld the number of bytes per row, 0 meaning w*d; rows are
+// stored top to bottom.
+// The buffer is only read during the call, so it may be reused for the next frame.
+func DrawImage(buf []uint8, x, y, w, h, d, ld int) error {
+	n, err := imageBufferLen(w, h, d, ld)
+	if n == 0 || err != nil {
+		return err
+	}
+	if len(buf) < n {
+		return ErrImageDataTooShort
+	}
+	C.go_fltk_draw_image((*C.uchar)(unsafe.Pointer(&buf[0])), C.int(x), C.int(y), C.int(w), C.int(h), C.int(d), C.int(ld))
+	return nil
+}
+
+// DrawImageMono draws a grayscale buffer like DrawImage. With d > 1 only the
+// first byte of every pixel is used.
+func DrawImageMono(buf []uint8, x, y, w, h, d, ld int) error {
+	n, err := imageBufferLen(w, h, d, ld)
+	if n == 0 || err != nil {
+		return err
+	}
+	if len(buf) < n {
+		return ErrImageDataTooShort
+	}
+	C.go_fltk_draw_image_mono((*C.uchar)(unsafe.Pointer(&buf[0])), C.int(x), C.int(y), C.int(w), C.int(h), C.int(d), C.int(ld))
+	return nil
+}
+
+// DrawRGBA draws img at x, y straight from its pixel buffer, honouring its stride.
+// Note that Go stores RGBA pixels alpha-premultiplied, so translucent pixels
+// will look darker than in an RgbImage created by NewRgbImageFromImage.
+func DrawRGBA(img *goimage.RGBA, x, y int) {
+	r := img.Rect
+	if r.Empty() {
+		return
+	}
+	buf := img.Pix[img.PixOffset(r.Min.X, r.Min.Y):]
+	C.go_fltk_draw_image((*C.uchar)(unsafe.Pointer(&buf[0])), C.int(x), C.int(y), C.int(r.Dx()), C.int(r.Dy()), 4, C.int(img.Stride))
+}
+
+// DrawGray draws img at x, y straight from its pixel buffer, honouring its stride.
+func DrawGray(img *goimage.Gray, x, y int) {
+	r := img.Rect
+	if r.Empty() {
+		return
+	}
+	buf := img.Pix[img.PixOffset(r.Min.X, r.Min.Y):]
+	C.go_fltk_draw_image_mono((*C.uchar)(unsafe.Pointer(&buf[0])), C.int(x), C.int(y), C.int(r.Dx()), C.int(r.Dy()), 1, C.int(img.Stride))
+}
+
+type drawImageLine struct {
+	gen   func(int, int, int, []uint8)
+	depth int
+}
+
+type drawImageLineMap struct {
+	lineMap map[uintptr]drawImageLine
+	id      uintptr
+}
+
+func newDrawImageLineMap() *drawImageLineMap {
+	return &drawImageLineMap{
+		lineMap: make(map[uintptr]drawImageLine),
+	}
+}
+func (m *drawImageLineMap) register(fn func(int, int, int, []uint8), depth int) uintptr {
+	m.id++
+	m.lineMap[m.id] = drawImageLine{gen: fn, depth: depth}
+	return m.id
+}
+func (m *drawImageLineMap) unregister(id uintptr) {
+	delete(m.lineMap, id)
+}
+func (m *drawImageLineMap) invoke(id uintptr, x, y, w int, buf *C.uchar) {
+	if line, ok := m.lineMap[id]; ok && line.gen != nil {
+		line.gen(x, y, w, unsafe.Slice((*uint8)(unsafe.Pointer(buf)), w*line.depth))
+	}
+}
+
+var globalDrawImageLineMap = newDrawImageLineMap()
+
+//export _go_drawImageLineHandler
+func _go_drawImageLineHandler(id C.uintptr_t, x, y, w C.int, buf *C.uchar) {
+	globalDrawImageLineMap.invoke(uintptr(id), int(x), int(y), int(w), buf)
+}
+
+// DrawImageFunc draws a w x h image at x, y whose pixels are produced one
+// scanline at a time by gen. gen is called with the offset x and line y
+// inside the image, the number of pixels w and a buffer of w*d bytes to fill.
+// The buffer belongs to FLTK and must not be retained after gen returns.
+func DrawImageFunc(x, y, w, h, d int, gen func(x, y, w int, buf []uint8)) {
+	id := globalDrawImageLineMap.register(gen, d)
+	defer globalDrawImageLineMap.unregister(id)
+	C.go_fltk_draw_image_cb(C.uintptr_t(id), C.int(x), C.int(y), C.int(w), C.int(h), C.int(d))
+}
+
+// DrawImageMonoFunc is the grayscale counterpart of DrawImageFunc.
+func DrawImageMonoFunc(x, y, w, h, d int, gen func(x, y, w int, buf []uint8)) {
+	id := globalDrawImageLineMap.register(gen, d)
+	defer globalDrawImageLineMap.unregister(id)
+	C.go_fltk_draw_image_mono_cb(C.uintptr_t(id), C.int(x), C.int(y), C.int(w), C.int(h), C.int(d))
+}
+
 type Offscreen struct {
 	oPtr *C.GOffscreen
 }
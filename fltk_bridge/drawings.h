@@ -1,5 +1,7 @@
 #pragma once
 
+#include <stdint.h>
+
 #ifdef __cplusplus
 extern "C" {
 #endif
@@ -97,6 +99,8 @@ extern "C" {
   extern void go_fltk_frame2(const char *s, int x, int y, int w, int h);
   extern void go_fltk_draw_image(const unsigned char *buf, int X, int Y, int W, int H, int D, int L);
   extern void go_fltk_draw_image_mono(const unsigned char *buf, int X, int Y, int W, int H, int D, int L);
+  extern void go_fltk_draw_image_cb(uintptr_t id, int X, int Y, int W, int H, int D);
+  extern void go_fltk_draw_image_mono_cb(uintptr_t id, int X, int Y, int W, int H, int D);
   extern char go_fltk_can_do_alpha_blending(void);
   extern unsigned char *go_fltk_read_image(unsigned char *p, int X, int Y, int W, int H, int alpha);
   extern unsigned char *go_fltk_capture_window_part(void *win, int x, int y, int w, int h);
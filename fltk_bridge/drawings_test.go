@@ -0,0 +1,46 @@
+package fltk_bridge
+
+import "testing"
+
+func TestImageBufferLen(t *testing.T) {
+	tests := []struct {
+		name        string
+		w, h, d, ld int
+		want        int
+		err         error
+	}{
+		{"rgb packed", 4, 3, 3, 0, 36, nil},
+		{"rgba packed", 2, 2, 4, 0, 16, nil},
+		{"mono packed", 5, 1, 1, 0, 5, nil},
+		{"padded rows", 4, 3, 3, 16, 2*16 + 12, nil},
+		{"single row ignores stride", 4, 1, 3, 100, 12, nil},
+		{"gray alpha", 3, 2, 2, 0, 12, nil},
+		{"zero width", 0, 3, 3, 0, 0, nil},
+		{"zero height", 4, 0, 3, 0, 0, nil},
+		{"negative width", -1, 3, 3, 0, 0, nil},
+		{"zero depth", 4, 3, 0, 0, 0, ErrInvalidImageLayout},
+		{"negative depth", 4, 3, -3, 0, 0, ErrInvalidImageLayout},
+		{"depth too large", 4, 3, 5, 0, 0, ErrInvalidImageLayout},
+		{"negative stride", 4, 3, 3, -12, 0, ErrInvalidImageLayout},
+		{"invalid depth of empty image", 0, 0, 0, 0, 0, ErrInvalidImageLayout},
+	}
+	for _, tt := range tests {
+		got, err := imageBufferLen(tt.w, tt.h, tt.d, tt.ld)
+		if got != tt.want || err != tt.err {
+			t.Errorf("%s: imageBufferLen(%d, %d, %d, %d) = %d, %v, want %d, %v", tt.name, tt.w, tt.h, tt.d, tt.ld, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestDrawImageRejectsInvalidLayout(t *testing.T) {
+	buf := make([]uint8, 64)
+	if err := DrawImage(buf, 0, 0, 2, 2, 3, -6); err != ErrInvalidImageLayout {
+		t.Errorf("DrawImage with a negative row length = %v, want %v", err, ErrInvalidImageLayout)
+	}
+	if err := DrawImageMono(buf, 0, 0, 2, 2, 0, 0); err != ErrInvalidImageLayout {
+		t.Errorf("DrawImageMono with depth 0 = %v, want %v", err, ErrInvalidImageLayout)
+	}
+	if err := DrawImage(buf[:10], 0, 0, 2, 2, 3, 0); err != ErrImageDataTooShort {
+		t.Errorf("DrawImage with a short buffer = %v, want %v", err, ErrImageDataTooShort)
+	}
+}
@@ -162,6 +162,18 @@ const (
 	WRAP_AT_BOUNDS = WrapMode(3)
 )
 
+// TextAttr are the extra attributes of a StyleTableEntry.
+type TextAttr uint
+
+const (
+	ATTR_BGCOLOR        = TextAttr(0x0001) // draw the background of the text in BgColor
+	ATTR_BGCOLOR_EXT    = TextAttr(0x0003) // like ATTR_BGCOLOR, extended to the end of the line
+	ATTR_UNDERLINE      = TextAttr(0x0004)
+	ATTR_GRAMMAR        = TextAttr(0x0008) // blue dotted underline
+	ATTR_SPELLING       = TextAttr(0x000C) // red dotted underline
+	ATTR_STRIKE_THROUGH = TextAttr(0x0010)
+)
+
 type Event int
 
 var (
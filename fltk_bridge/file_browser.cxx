@@ -0,0 +1,48 @@
+#include "file_browser.h"
+
+#include <FL/Fl_File_Browser.H>
+#include <FL/Fl_File_Icon.H>
+
+#include "event_handler.h"
+
+
+const int go_FL_FileBrowser_FILES = Fl_File_Browser::FILES;
+const int go_FL_FileBrowser_DIRECTORIES = Fl_File_Browser::DIRECTORIES;
+
+class GFileBrowser : public EventHandler<Fl_File_Browser> {
+public:
+  GFileBrowser(int x, int y, int w, int h, const char *label)
+    : EventHandler<Fl_File_Browser>(x, y, w, h, label) {}
+};
+
+GFileBrowser* go_fltk_new_File_Browser(int x, int y, int w, int h, const char* text) {
+  return new GFileBrowser(x, y, w, h, text);
+}
+int go_fltk_File_Browser_load(Fl_File_Browser* b, const char* directory) {
+  return b->load(directory);
+}
+const char* go_fltk_File_Browser_errmsg(Fl_File_Browser* b) {
+  return b->errmsg();
+}
+void go_fltk_File_Browser_set_filter(Fl_File_Browser* b, const char* pattern) {
+  b->filter(pattern);
+}
+void go_fltk_File_Browser_set_filetype(Fl_File_Browser* b, int filetype) {
+  b->filetype(filetype);
+}
+int go_fltk_File_Browser_filetype(Fl_File_Browser* b) {
+  return b->filetype();
+}
+void go_fltk_File_Browser_set_iconsize(Fl_File_Browser* b, int size) {
+  b->iconsize((uchar)size);
+}
+int go_fltk_File_Browser_iconsize(Fl_File_Browser* b) {
+  return b->iconsize();
+}
+void go_fltk_File_Browser_set_textsize(Fl_File_Browser* b, int size) {
+  b->textsize(size);
+}
+
+void go_fltk_load_system_file_icons() {
+  Fl_File_Icon::load_system_icons();
+}
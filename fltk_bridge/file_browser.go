@@ -0,0 +1,122 @@
+package fltk_bridge
+
+/*
+#include <stdlib.h>
+#include "file_browser.h"
+*/
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+type FileBrowserType int
+
+var (
+	// FileBrowserFiles lists both files and directories.
+	FileBrowserFiles = FileBrowserType(C.go_FL_FileBrowser_FILES)
+	// FileBrowserDirectories lists only directories.
+	FileBrowserDirectories = FileBrowserType(C.go_FL_FileBrowser_DIRECTORIES)
+)
+
+// FileBrowser lists the files of a directory, each with the icon of its
+// file type once LoadSystemFileIcons was called, to be embedded in a window
+// rather than shown as a dialog like FileChooser. Lines are file names,
+// directories ending with a slash.
+//
+// Lines store their icon as their data, so AddWithData must not be used.
+type FileBrowser struct {
+	Browser
+	// filter is the pattern, which the browser keeps without copying.
+	filter *C.char
+}
+
+func NewFileBrowser(x, y, w, h int, text ...string) *FileBrowser {
+	b := &FileBrowser{}
+	b.dataMap = newBrowserDataMap()
+	b.icons = make(map[int]Image)
+	initWidget(b, unsafe.Pointer(C.go_fltk_new_File_Browser(C.int(x), C.int(y), C.int(w), C.int(h), cStringOpt(text))))
+	var deletionHandlerId uintptr
+	deletionHandlerId = b.addDeletionHandler(func() {
+		if b.filter != nil {
+			C.free(unsafe.Pointer(b.filter))
+			b.filter = nil
+		}
+		globalCallbackMap.unregister(deletionHandlerId)
+	})
+	return b
+}
+
+func (b *FileBrowser) cBrowser() *C.Fl_File_Browser {
+	return (*C.Fl_File_Browser)(unsafe.Pointer(b.ptr()))
+}
+
+// Load replaces the lines with the files of directory matching the filter,
+// sorted numerically, and returns how many there are.
+func (b *FileBrowser) Load(directory string) (int, error) {
+	cDir := C.CString(directory)
+	defer C.free(unsafe.Pointer(cDir))
+
+	n := int(C.go_fltk_File_Browser_load(b.cBrowser(), cDir))
+	// loading replaces all lines, whatever it finds
+	b.forgetLines()
+	if n == 0 {
+		if msg := C.go_fltk_File_Browser_errmsg(b.cBrowser()); msg != nil {
+			return 0, errors.New(C.GoString(msg))
+		}
+	}
+	return n, nil
+}
+
+// SetFilter sets the pattern the names of listed files must match, such as
+// "*.{png,jpg}" or "*.txt", taking effect at the next Load. Directories are
+// always listed. An empty pattern lists all files.
+func (b *FileBrowser) SetFilter(pattern string) {
+	old := b.filter
+	b.filter = nil
+	if pattern != "" {
+		b.filter = C.CString(pattern)
+	}
+	C.go_fltk_File_Browser_set_filter(b.cBrowser(), b.filter)
+	if old != nil {
+		C.free(unsafe.Pointer(old))
+	}
+}
+
+func (b *FileBrowser) Filter() string {
+	if b.filter == nil {
+		return ""
+	}
+	return C.GoString(b.filter)
+}
+
+// SetFileType sets whether files are listed, taking effect at the next Load.
+func (b *FileBrowser) SetFileType(fileType FileBrowserType) {
+	C.go_fltk_File_Browser_set_filetype(b.cBrowser(), C.int(fileType))
+}
+
+func (b *FileBrowser) FileType() FileBrowserType {
+	return FileBrowserType(C.go_fltk_File_Browser_filetype(b.cBrowser()))
+}
+
+// SetIconSize sets the size of the file type icons, from 1 to 255.
+func (b *FileBrowser) SetIconSize(size int) {
+	C.go_fltk_File_Browser_set_iconsize(b.cBrowser(), C.int(size))
+}
+
+func (b *FileBrowser) IconSize() int {
+	return int(C.go_fltk_File_Browser_iconsize(b.cBrowser()))
+}
+
+// SetTextSize sets the size of the file names, making the icons one and a
+// half times as large.
+func (b *FileBrowser) SetTextSize(size int) {
+	C.go_fltk_File_Browser_set_textsize(b.cBrowser(), C.int(size))
+}
+
+// LoadSystemFileIcons loads the icons of the desktop for file types, or
+// plain folder and file icons where there are none, for FileBrowser to show
+// next to file names. Until it is called, files are listed without icons.
+func LoadSystemFileIcons() {
+	C.go_fltk_load_system_file_icons()
+}
@@ -0,0 +1,27 @@
+#pragma once
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+  typedef struct GFileBrowser GFileBrowser;
+  typedef struct Fl_File_Browser Fl_File_Browser;
+
+  extern const int go_FL_FileBrowser_FILES;
+  extern const int go_FL_FileBrowser_DIRECTORIES;
+
+  extern GFileBrowser* go_fltk_new_File_Browser(int x, int y, int w, int h, const char* text);
+  extern int go_fltk_File_Browser_load(Fl_File_Browser* b, const char* directory);
+  extern const char* go_fltk_File_Browser_errmsg(Fl_File_Browser* b);
+  extern void go_fltk_File_Browser_set_filter(Fl_File_Browser* b, const char* pattern);
+  extern void go_fltk_File_Browser_set_filetype(Fl_File_Browser* b, int filetype);
+  extern int go_fltk_File_Browser_filetype(Fl_File_Browser* b);
+  extern void go_fltk_File_Browser_set_iconsize(Fl_File_Browser* b, int size);
+  extern int go_fltk_File_Browser_iconsize(Fl_File_Browser* b);
+  extern void go_fltk_File_Browser_set_textsize(Fl_File_Browser* b, int size);
+
+  extern void go_fltk_load_system_file_icons();
+
+#ifdef __cplusplus
+}
+#endif
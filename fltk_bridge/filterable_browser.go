@@ -0,0 +1,148 @@
+package fltk_bridge
+
+/*
+#include "group.h"
+*/
+import "C"
+import (
+	"strings"
+	"unsafe"
+)
+
+const filterInputHeight = 25
+
+// BrowserWidget is any of the browsers built on Browser: Browser itself,
+// SelectBrowser, HoldBrowser, MultiBrowser and FileBrowser.
+type BrowserWidget interface {
+	Widget
+	getBrowser() *Browser
+}
+
+func (b *Browser) getBrowser() *Browser { return b }
+
+// FilterableBrowser is a filter field above a browser, which hides the
+// lines that do not contain the text typed in the field, ignoring case and
+// the @ formatting codes of the lines. Down moves the keyboard focus from the
+// field to the browser and Escape clears the field.
+//
+// Lines added to or loaded into the browser are shown whatever the filter;
+// call Refilter after changing them.
+type FilterableBrowser struct {
+	Group
+	input   *Input
+	browser *Browser
+	match   func(text, query string) bool
+}
+
+// NewFilterableBrowser moves b into a new FilterableBrowser, below the
+// filter field, and makes it take the rest of the space.
+func NewFilterableBrowser(x, y, w, h int, b BrowserWidget) *FilterableBrowser {
+	f := &FilterableBrowser{browser: b.getBrowser()}
+	initWidget(f, unsafe.Pointer(C.go_fltk_new_Group(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+
+	f.input = NewInput(x, y, w, filterInputHeight)
+	f.input.SetTooltip("Filter")
+	f.End()
+	f.browser.Resize(x, y+filterInputHeight, w, h-filterInputHeight)
+	f.Add(b)
+	f.Resizable(b)
+
+	f.input.SetCallbackCondition(WhenChanged)
+	f.input.SetCallback(func() { f.Refilter() })
+	f.input.SetEventHandler(func(e Event) bool {
+		if e != KEYDOWN {
+			return false
+		}
+		switch EventKey() {
+		case DOWN:
+			f.browser.TakeFocus()
+			return true
+		case ESCAPE:
+			f.SetQuery("")
+			return true
+		}
+		return false
+	})
+	return f
+}
+
+func (f *FilterableBrowser) Browser() *Browser { return f.browser }
+func (f *FilterableBrowser) Input() *Input     { return f.input }
+
+func (f *FilterableBrowser) Query() string { return f.input.Value() }
+
+// SetQuery sets the text of the filter field and filters the lines by it.
+func (f *FilterableBrowser) SetQuery(query string) {
+	f.input.SetValue(query)
+	f.Refilter()
+}
+
+// SetMatchFunc sets the function deciding whether a line, whose text is
+// given without its formatting codes, matches the query, replacing the
+// case-insensitive substring match. A nil function restores it. An empty
+// query matches all lines.
+func (f *FilterableBrowser) SetMatchFunc(match func(text, query string) bool) {
+	f.match = match
+	f.Refilter()
+}
+
+// Refilter shows the lines that match the query and hides the others, and
+// returns how many match.
+func (f *FilterableBrowser) Refilter() int {
+	b := f.browser
+	query := f.input.Value()
+	match := f.match
+	if match == nil {
+		query = strings.ToLower(query)
+		match = func(text, query string) bool {
+			return strings.Contains(strings.ToLower(text), query)
+		}
+	}
+	format, column := byte(b.FormatChar()), byte(b.ColumnChar())
+	matched := 0
+	for line := 1; line <= b.Size(); line++ {
+		if query == "" || match(browserLineText(b.Text(line), format, column), query) {
+			b.ShowLine(line)
+			matched++
+		} else {
+			b.HideLine(line)
+		}
+	}
+	return matched
+}
+
+// browserLineText returns text without the formatting codes starting each
+// of its columns, with the columns separated by spaces.
+func browserLineText(text string, format, column byte) string {
+	if format == 0 {
+		return text
+	}
+	var columns []string
+	if column == 0 {
+		columns = []string{text}
+	} else {
+		columns = strings.Split(text, string(column))
+	}
+	for i, col := range columns {
+		columns[i] = stripBrowserFormat(col, format)
+	}
+	return strings.Join(columns, " ")
+}
+
+// stripBrowserFormat removes the formatting codes at the start of text,
+// such as "@b@C1", that the browser does not display.
+func stripBrowserFormat(text string, format byte) string {
+	for len(text) >= 2 && text[0] == format {
+		code := text[1]
+		text = text[2:]
+		switch code {
+		case '.':
+			return text
+		case format:
+			return string(format) + text
+		case 'B', 'C', 'F', 'S':
+			text = strings.TrimLeft(text, "0123456789")
+		}
+	}
+	return text
+}
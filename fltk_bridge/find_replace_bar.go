@@ -0,0 +1,314 @@
+package fltk_bridge
+
+/*
+#include "group.h"
+*/
+import "C"
+import (
+	"fmt"
+	"regexp"
+	"unsafe"
+)
+
+// FindReplaceBar is a two row search panel for a TextEditor: a find field
+// with match case, whole word and regexp toggles, previous/next buttons and
+// a result counter, and a replace field with replace and replace all
+// buttons. Typing in the find field searches incrementally; Enter goes to
+// the next match, Shift+Enter to the previous one.
+type FindReplaceBar struct {
+	Group
+	findInput    *Input
+	replaceInput *Input
+	matchCase    *ToggleButton
+	wholeWord    *ToggleButton
+	useRegexp    *ToggleButton
+	status       *Box
+	editor       *TextEditor
+	// anchor is where incremental search starts while the query is typed.
+	anchor int
+	// matchStart and matchEnd delimit the current match if hasMatch is set.
+	matchStart, matchEnd int
+	hasMatch             bool
+}
+
+func NewFindReplaceBar(x, y, w, h int) *FindReplaceBar {
+	f := &FindReplaceBar{}
+	initWidget(f, unsafe.Pointer(C.go_fltk_new_Group(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+
+	const button, statusW = 30, 100
+	rowH := h / 2
+	inputW := max(w-6*button-statusW, button)
+	bx := x + inputW
+
+	f.Begin()
+	f.findInput = NewInput(x, y, inputW, rowH)
+	f.findInput.SetTooltip("Find")
+	f.matchCase = NewToggleButton(bx, y, button, rowH, "Aa")
+	f.matchCase.SetTooltip("Match case")
+	f.wholeWord = NewToggleButton(bx+button, y, button, rowH, "W")
+	f.wholeWord.SetTooltip("Whole word")
+	f.useRegexp = NewToggleButton(bx+2*button, y, button, rowH, ".*")
+	f.useRegexp.SetTooltip("Regular expression")
+	prev := NewButton(bx+3*button, y, button, rowH, "@<")
+	prev.SetTooltip("Previous match")
+	next := NewButton(bx+4*button, y, button, rowH, "@>")
+	next.SetTooltip("Next match")
+	f.status = NewBox(NO_BOX, bx+5*button, y, w-inputW-5*button, rowH)
+	f.status.SetAlign(ALIGN_LEFT | ALIGN_INSIDE)
+
+	f.replaceInput = NewInput(x, y+rowH, inputW, h-rowH)
+	f.replaceInput.SetTooltip("Replace")
+	replace := NewButton(bx, y+rowH, 3*button, h-rowH, "Replace")
+	replaceAll := NewButton(bx+3*button, y+rowH, 3*button, h-rowH, "All")
+	f.End()
+	f.Resizable(f.findInput)
+
+	f.findInput.SetCallbackCondition(WhenChanged)
+	f.findInput.SetCallback(func() { f.search(f.anchor, false) })
+	f.findInput.SetEventHandler(func(e Event) bool {
+		if e != KEYDOWN {
+			return false
+		}
+		switch EventKey() {
+		case ENTER_KEY:
+			if EventState()&SHIFT != 0 {
+				f.FindPrevious()
+			} else {
+				f.FindNext()
+			}
+			return true
+		case ESCAPE:
+			if f.editor != nil {
+				f.editor.TakeFocus()
+			}
+			return true
+		}
+		return false
+	})
+	f.replaceInput.SetEventHandler(func(e Event) bool {
+		if e == KEYDOWN && EventKey() == ENTER_KEY {
+			f.ReplaceNext()
+			return true
+		}
+		return false
+	})
+	for _, toggle := range []*ToggleButton{f.matchCase, f.wholeWord, f.useRegexp} {
+		toggle.SetCallback(func() { f.search(f.anchor, false) })
+	}
+	prev.SetCallback(func() { f.FindPrevious() })
+	next.SetCallback(func() { f.FindNext() })
+	replace.SetCallback(func() { f.ReplaceNext() })
+	replaceAll.SetCallback(func() { f.ReplaceAll() })
+	return f
+}
+
+// Attach makes the bar search the buffer of editor, starting at its cursor.
+func (f *FindReplaceBar) Attach(editor *TextEditor) {
+	f.Detach()
+	f.editor = editor
+	if buf := f.buffer(); buf != nil {
+		f.anchor = editor.GetInsertPosition()
+	}
+}
+
+// Detach removes the match highlight and disconnects the bar from its editor.
+func (f *FindReplaceBar) Detach() {
+	if buf := f.buffer(); buf != nil {
+		buf.UnHighlight()
+	}
+	f.editor = nil
+	f.hasMatch = false
+	f.status.SetLabel("")
+}
+
+func (f *FindReplaceBar) Editor() *TextEditor { return f.editor }
+
+// Focus moves the keyboard focus to the find field and selects its text.
+func (f *FindReplaceBar) Focus() {
+	f.findInput.TakeFocus()
+	f.findInput.SetInsertPosition(len(f.findInput.Value()), 0)
+	if f.editor != nil {
+		f.anchor = f.editor.GetInsertPosition()
+	}
+}
+
+func (f *FindReplaceBar) Query() string { return f.findInput.Value() }
+
+// SetQuery sets the text to find and searches for it from the cursor.
+func (f *FindReplaceBar) SetQuery(query string) {
+	f.findInput.SetValue(query)
+	f.search(f.anchor, false)
+}
+
+func (f *FindReplaceBar) Replacement() string        { return f.replaceInput.Value() }
+func (f *FindReplaceBar) SetReplacement(repl string) { f.replaceInput.SetValue(repl) }
+func (f *FindReplaceBar) MatchCase() bool            { return f.matchCase.Value() }
+func (f *FindReplaceBar) SetMatchCase(on bool)       { f.matchCase.SetValue(on) }
+func (f *FindReplaceBar) WholeWord() bool            { return f.wholeWord.Value() }
+func (f *FindReplaceBar) SetWholeWord(on bool)       { f.wholeWord.SetValue(on) }
+func (f *FindReplaceBar) UseRegexp() bool            { return f.useRegexp.Value() }
+func (f *FindReplaceBar) SetUseRegexp(on bool)       { f.useRegexp.SetValue(on) }
+
+// FindNext moves to the next match, wrapping around at the end of the text.
+func (f *FindReplaceBar) FindNext() bool {
+	buf := f.buffer()
+	if buf == nil {
+		return false
+	}
+	from := f.editor.GetInsertPosition()
+	if f.hasMatch {
+		from = f.matchEnd
+	}
+	if !f.search(from, false) {
+		return false
+	}
+	f.anchor = f.matchStart
+	return true
+}
+
+// FindPrevious moves to the previous match, wrapping around at the start.
+func (f *FindReplaceBar) FindPrevious() bool {
+	buf := f.buffer()
+	if buf == nil {
+		return false
+	}
+	before := f.editor.GetInsertPosition()
+	if f.hasMatch {
+		before = f.matchStart
+	}
+	if !f.search(before, true) {
+		return false
+	}
+	f.anchor = f.matchStart
+	return true
+}
+
+// ReplaceNext replaces the current match and moves to the next one.
+func (f *FindReplaceBar) ReplaceNext() bool {
+	buf := f.buffer()
+	re := f.pattern()
+	if buf == nil || re == nil {
+		return false
+	}
+	if !f.hasMatch {
+		return f.FindNext()
+	}
+	text := buf.Text()
+	loc := submatchAt(re, text, f.matchStart, f.matchEnd)
+	if loc == nil {
+		return f.FindNext()
+	}
+	repl := f.replaceInput.Value()
+	if f.useRegexp.Value() {
+		repl = string(re.ExpandString(nil, repl, text, loc))
+	}
+	buf.ReplaceRange(f.matchStart, f.matchEnd, repl)
+	f.hasMatch = false
+	if !f.search(f.matchStart+len(repl), false) {
+		return false
+	}
+	f.anchor = f.matchStart
+	return true
+}
+
+// ReplaceAll replaces every match as a single undoable edit and returns how
+// many were replaced.
+func (f *FindReplaceBar) ReplaceAll() int {
+	buf := f.buffer()
+	re := f.pattern()
+	if buf == nil || re == nil {
+		return 0
+	}
+	var n int
+	if f.useRegexp.Value() {
+		n = buf.ReplaceAll(re, f.replaceInput.Value())
+	} else {
+		n = buf.ReplaceAllLiteral(re, f.replaceInput.Value())
+	}
+	buf.UnHighlight()
+	f.hasMatch = false
+	f.status.SetLabel(fmt.Sprintf("Replaced %d", n))
+	return n
+}
+
+func (f *FindReplaceBar) buffer() *TextBuffer {
+	if f.editor == nil || !f.editor.exists() {
+		return nil
+	}
+	return f.editor.Buffer()
+}
+
+// pattern compiles the query according to the toggles. It returns nil and
+// reports the problem in the status box if there is nothing to search for.
+func (f *FindReplaceBar) pattern() *regexp.Regexp {
+	q := f.findInput.Value()
+	if q == "" {
+		f.status.SetLabel("")
+		return nil
+	}
+	if !f.useRegexp.Value() {
+		q = regexp.QuoteMeta(q)
+	}
+	if f.wholeWord.Value() {
+		q = `\b(?:` + q + `)\b`
+	}
+	if !f.matchCase.Value() {
+		q = "(?i)" + q
+	}
+	re, err := regexp.Compile(q)
+	if err != nil {
+		f.status.SetLabel("Invalid pattern")
+		return nil
+	}
+	return re
+}
+
+// search looks for the next match from pos, or the previous one before pos,
+// wrapping around once. Empty matches are skipped.
+func (f *FindReplaceBar) search(pos int, backward bool) bool {
+	buf := f.buffer()
+	if buf == nil {
+		return false
+	}
+	re := f.pattern()
+	f.hasMatch = false
+	if re == nil {
+		buf.UnHighlight()
+		return false
+	}
+	var matches [][]int
+	for _, m := range buf.FindAll(re) {
+		if m[1] > m[0] {
+			matches = append(matches, m)
+		}
+	}
+	if len(matches) == 0 {
+		buf.UnHighlight()
+		f.status.SetLabel("No results")
+		return false
+	}
+	index := 0
+	if backward {
+		index = len(matches) - 1
+		for i := len(matches) - 1; i >= 0; i-- {
+			if matches[i][1] <= pos {
+				index = i
+				break
+			}
+		}
+	} else {
+		for i, m := range matches {
+			if m[0] >= pos {
+				index = i
+				break
+			}
+		}
+	}
+	m := matches[index]
+	f.matchStart, f.matchEnd, f.hasMatch = m[0], m[1], true
+	buf.Highlight(m[0], m[1])
+	f.editor.SetInsertPosition(m[1])
+	f.editor.ShowInsertPosition()
+	f.status.SetLabel(fmt.Sprintf("%d of %d", index+1, len(matches)))
+	return true
+}
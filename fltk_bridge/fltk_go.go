@@ -111,6 +111,12 @@ func cStringOpt(s []string) *C.char {
 	}
 	return C.CString(s[0])
 }
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
 
 type awakeMap struct {
 	mutex    sync.Mutex
@@ -32,8 +32,14 @@ func (g *Group) Remove(w Widget) {
 	C.go_fltk_Group_remove((*C.Fl_Group)(g.ptr()), w.getWidget().ptr())
 }
 
+// Resizable sets the child that grows and shrinks as the group is resized.
+// If w is nil, resizing the group only moves its children.
 func (g *Group) Resizable(w Widget) {
-	C.go_fltk_Group_resizable((*C.Fl_Group)(g.ptr()), w.getWidget().ptr())
+	var p *C.Fl_Widget
+	if w != nil {
+		p = w.getWidget().ptr()
+	}
+	C.go_fltk_Group_resizable((*C.Fl_Group)(g.ptr()), p)
 }
 func (g *Group) DrawChildren() {
 	C.go_fltk_Group_draw_children((*C.Fl_Group)(g.ptr()))
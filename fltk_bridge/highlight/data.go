@@ -0,0 +1,251 @@
+package highlight
+
+import "bytes"
+
+// JSON highlights JSON documents. Object keys are told apart from string
+// values.
+var JSON Lexer = LexerFunc(lexJSON)
+
+func lexJSON(line []byte, _ State) ([]Token, State) {
+	var toks tokens
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == '"':
+			end, _ := scanQuoted(line, i, true)
+			if next := skipSpace(line, end); next < len(line) && line[next] == ':' {
+				toks.add(i, end, Key)
+			} else {
+				toks.add(i, end, String)
+			}
+			i = end
+		case c == '-' || isDigit(c):
+			end := scanNumber(line, i+1)
+			toks.add(i, end, Number)
+			i = end
+		case isIdentStart(c):
+			end := scanIdent(line, i)
+			switch string(line[i:end]) {
+			case "true", "false", "null":
+				toks.add(i, end, Keyword)
+			default:
+				toks.add(i, end, Error)
+			}
+			i = end
+		case bytes.IndexByte([]byte("{}[],:"), c) >= 0:
+			toks.add(i, i+1, Punctuation)
+			i++
+		default:
+			toks.add(i, i+1, Error)
+			i++
+		}
+	}
+	return toks, 0
+}
+
+// YAML highlights YAML documents, including block scalars introduced with
+// | or > whose lines are shown as strings.
+var YAML Lexer = LexerFunc(lexYAML)
+
+// A YAML state of n > 0 means the previous lines belong to a block scalar of
+// a key indented by n-1 columns.
+func lexYAML(line []byte, state State) ([]Token, State) {
+	var toks tokens
+	indent := skipSpace(line, 0)
+	if state > 0 {
+		if indent == len(line) || indent > int(state)-1 {
+			toks.add(indent, len(line), String)
+			return toks, state
+		}
+	}
+	if indent == len(line) {
+		return toks, 0
+	}
+	if indent == 0 && (bytes.HasPrefix(line, []byte("---")) || bytes.HasPrefix(line, []byte("..."))) {
+		toks.add(0, 3, Keyword)
+		yamlComment(&toks, line, 3)
+		return toks, 0
+	}
+	i := indent
+	for i+1 < len(line) && line[i] == '-' && isSpace(line[i+1]) {
+		toks.add(i, i+1, Punctuation)
+		i = skipSpace(line, i+1)
+	}
+	if i < len(line) && line[i] == '-' && i+1 == len(line) {
+		toks.add(i, i+1, Punctuation)
+		return toks, 0
+	}
+	if i < len(line) && line[i] == '#' {
+		toks.add(i, len(line), Comment)
+		return toks, 0
+	}
+	keyIndent := i
+	if end := yamlKeyEnd(line, i); end >= 0 {
+		toks.add(i, end, Key)
+		toks.add(end, end+1, Punctuation)
+		i = end + 1
+	}
+	if block := yamlValue(&toks, line, i); block {
+		return toks, State(keyIndent + 1)
+	}
+	return toks, 0
+}
+
+// yamlKeyEnd returns the index of the colon ending a mapping key that starts
+// at i, or -1 if the line holds no key.
+func yamlKeyEnd(line []byte, i int) int {
+	if i < len(line) && (line[i] == '"' || line[i] == '\'') {
+		end, closed := scanQuoted(line, i, line[i] == '"')
+		if closed && end < len(line) && line[end] == ':' {
+			return end
+		}
+		return -1
+	}
+	for j := i; j < len(line); j++ {
+		switch line[j] {
+		case ':':
+			if j+1 == len(line) || isSpace(line[j+1]) {
+				return j
+			}
+		case '#':
+			if j > i && isSpace(line[j-1]) {
+				return -1
+			}
+		case '[', '{', '"', '\'':
+			if j == i {
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// yamlValue tokenizes the value starting at i and reports whether it opens a
+// block scalar.
+func yamlValue(toks *tokens, line []byte, i int) bool {
+	i = skipSpace(line, i)
+	if i == len(line) {
+		return false
+	}
+	switch c := line[i]; {
+	case c == '#':
+		toks.add(i, len(line), Comment)
+		return false
+	case c == '|' || c == '>':
+		end := i + 1
+		for end < len(line) && bytes.IndexByte([]byte("+-0123456789"), line[end]) >= 0 {
+			end++
+		}
+		toks.add(i, end, Operator)
+		yamlComment(toks, line, end)
+		return true
+	case c == '"' || c == '\'':
+		end, _ := scanQuoted(line, i, c == '"')
+		toks.add(i, end, String)
+		yamlComment(toks, line, end)
+		return false
+	case c == '&' || c == '*' || c == '!':
+		end := i + 1
+		for end < len(line) && !isSpace(line[end]) {
+			end++
+		}
+		if c == '!' {
+			toks.add(i, end, Type)
+		} else {
+			toks.add(i, end, Variable)
+		}
+		return yamlValue(toks, line, end)
+	case c == '[' || c == '{' || c == ']' || c == '}' || c == ',':
+		toks.add(i, i+1, Punctuation)
+		return yamlValue(toks, line, i+1)
+	}
+	end := i
+	for end < len(line) && !(line[end] == '#' && isSpace(line[end-1])) {
+		end++
+	}
+	for end > i && isSpace(line[end-1]) {
+		end--
+	}
+	toks.add(i, end, yamlScalarKind(line[i:end]))
+	yamlComment(toks, line, end)
+	return false
+}
+
+func yamlScalarKind(v []byte) Kind {
+	switch string(v) {
+	case "true", "false", "yes", "no", "on", "off", "True", "False", "null", "Null", "~":
+		return Keyword
+	}
+	if len(v) > 0 && (isDigit(v[0]) || len(v) > 1 && (v[0] == '-' || v[0] == '.') && isDigit(v[1])) {
+		if scanNumber(v, 1) == len(v) {
+			return Number
+		}
+	}
+	return String
+}
+
+func yamlComment(toks *tokens, line []byte, i int) {
+	i = skipSpace(line, i)
+	if i < len(line) && line[i] == '#' {
+		toks.add(i, len(line), Comment)
+	}
+}
+
+// INI highlights INI style configuration files: [sections], key = value
+// pairs and lines commented with ; or #.
+var INI Lexer = LexerFunc(lexINI)
+
+func lexINI(line []byte, _ State) ([]Token, State) {
+	var toks tokens
+	i := skipSpace(line, 0)
+	if i == len(line) {
+		return toks, 0
+	}
+	switch line[i] {
+	case ';', '#':
+		toks.add(i, len(line), Comment)
+		return toks, 0
+	case '[':
+		end := bytes.IndexByte(line[i:], ']')
+		if end < 0 {
+			toks.add(i, len(line), Error)
+			return toks, 0
+		}
+		toks.add(i, i+end+1, Section)
+		return toks, 0
+	}
+	sep := bytes.IndexAny(line[i:], "=:")
+	if sep < 0 {
+		toks.add(i, len(line), Key)
+		return toks, 0
+	}
+	sep += i
+	keyEnd := sep
+	for keyEnd > i && isSpace(line[keyEnd-1]) {
+		keyEnd--
+	}
+	toks.add(i, keyEnd, Key)
+	toks.add(sep, sep+1, Operator)
+	value := skipSpace(line, sep+1)
+	end := len(line)
+	for j := value; j < len(line); j++ {
+		if (line[j] == ';' || line[j] == '#') && j > value && isSpace(line[j-1]) {
+			end = j
+			break
+		}
+	}
+	comment := end
+	for end > value && isSpace(line[end-1]) {
+		end--
+	}
+	v := line[value:end]
+	if len(v) > 0 && scanNumber(v, 0) == len(v) && (isDigit(v[0]) || v[0] == '.') {
+		toks.add(value, end, Number)
+	} else {
+		toks.add(value, end, String)
+	}
+	toks.add(comment, len(line), Comment)
+	return toks, 0
+}
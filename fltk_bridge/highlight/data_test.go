@@ -0,0 +1,52 @@
+package highlight
+
+import "testing"
+
+func TestJSONKeysAndValues(t *testing.T) {
+	expectTokens(t, JSON, []string{
+		`{"name": "x", "n": -1.5, "ok": true, "bad": nope}`,
+	}, [][]string{
+		{"Punctuation({)", `Key("name")`, "Punctuation(:)", `String("x")`, "Punctuation(,)",
+			`Key("n")`, "Punctuation(:)", "Number(-1.5)", "Punctuation(,)",
+			`Key("ok")`, "Punctuation(:)", "Keyword(true)", "Punctuation(,)",
+			`Key("bad")`, "Punctuation(:)", "Error(nope)", "Punctuation(})"},
+	})
+}
+
+func TestYAMLBlockScalars(t *testing.T) {
+	expectTokens(t, YAML, []string{
+		"key: |",
+		"  line one",
+		"",
+		"  # not a comment",
+		"next: 2",
+		"list:",
+		"  - text: >-",
+		"      folded",
+		"    other: yes # note",
+	}, [][]string{
+		{"Key(key)", "Punctuation(:)", "Operator(|)"},
+		{"String(line one)"},
+		{},
+		{"String(# not a comment)"},
+		{"Key(next)", "Punctuation(:)", "Number(2)"},
+		{"Key(list)", "Punctuation(:)"},
+		{"Punctuation(-)", "Key(text)", "Punctuation(:)", "Operator(>-)"},
+		{"String(folded)"},
+		{"Key(other)", "Punctuation(:)", "Keyword(yes)", "Comment(# note)"},
+	})
+}
+
+func TestINISectionsAndPairs(t *testing.T) {
+	expectTokens(t, INI, []string{
+		"[server]",
+		"port = 8080 ; default",
+		"name: web",
+		"# comment",
+	}, [][]string{
+		{"Section([server])"},
+		{"Key(port)", "Operator(=)", "Number(8080)", "Comment(; default)"},
+		{"Key(name)", "Operator(:)", "String(web)"},
+		{"Comment(# comment)"},
+	})
+}
@@ -0,0 +1,102 @@
+package highlight
+
+import "bytes"
+
+// Go highlights Go source code.
+var Go Lexer = LexerFunc(lexGo)
+
+const (
+	goNormal State = iota
+	goBlockComment
+	goRawString
+)
+
+var (
+	goKeywords = wordSet("break", "case", "chan", "const", "continue", "default", "defer",
+		"else", "fallthrough", "for", "func", "go", "goto", "if", "import", "interface",
+		"map", "package", "range", "return", "select", "struct", "switch", "type", "var")
+	goTypes = wordSet("any", "bool", "byte", "comparable", "complex64", "complex128", "error",
+		"float32", "float64", "int", "int8", "int16", "int32", "int64", "rune", "string",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr")
+	goBuiltins = wordSet("append", "cap", "clear", "close", "complex", "copy", "delete", "imag",
+		"len", "make", "max", "min", "new", "panic", "print", "println", "real", "recover",
+		"true", "false", "iota", "nil")
+)
+
+func lexGo(line []byte, state State) ([]Token, State) {
+	var toks tokens
+	i := 0
+	switch state {
+	case goBlockComment:
+		end := scanUntil(line, 0, "*/")
+		if end < 0 {
+			toks.add(0, len(line), Comment)
+			return toks, goBlockComment
+		}
+		toks.add(0, end, Comment)
+		i = end
+	case goRawString:
+		end := bytes.IndexByte(line, '`')
+		if end < 0 {
+			toks.add(0, len(line), String)
+			return toks, goRawString
+		}
+		toks.add(0, end+1, String)
+		i = end + 1
+	}
+	for i < len(line) {
+		c := line[i]
+		switch {
+		case isSpace(c):
+			i++
+		case bytes.HasPrefix(line[i:], []byte("//")):
+			toks.add(i, len(line), Comment)
+			return toks, goNormal
+		case bytes.HasPrefix(line[i:], []byte("/*")):
+			end := scanUntil(line, i+2, "*/")
+			if end < 0 {
+				toks.add(i, len(line), Comment)
+				return toks, goBlockComment
+			}
+			toks.add(i, end, Comment)
+			i = end
+		case c == '"' || c == '\'':
+			end, _ := scanQuoted(line, i, true)
+			toks.add(i, end, String)
+			i = end
+		case c == '`':
+			end := bytes.IndexByte(line[i+1:], '`')
+			if end < 0 {
+				toks.add(i, len(line), String)
+				return toks, goRawString
+			}
+			toks.add(i, i+end+2, String)
+			i += end + 2
+		case isDigit(c) || c == '.' && i+1 < len(line) && isDigit(line[i+1]):
+			end := scanNumber(line, i)
+			toks.add(i, end, Number)
+			i = end
+		case isIdentStart(c):
+			end := scanIdent(line, i)
+			word := string(line[i:end])
+			switch {
+			case goKeywords[word]:
+				toks.add(i, end, Keyword)
+			case goTypes[word]:
+				toks.add(i, end, Type)
+			case goBuiltins[word]:
+				toks.add(i, end, Builtin)
+			}
+			i = end
+		case bytes.IndexByte([]byte("+-*/%&|^<>=!:~"), c) >= 0:
+			toks.add(i, i+1, Operator)
+			i++
+		case bytes.IndexByte([]byte("(){}[],;."), c) >= 0:
+			toks.add(i, i+1, Punctuation)
+			i++
+		default:
+			i++
+		}
+	}
+	return toks, goNormal
+}
@@ -0,0 +1,38 @@
+package highlight
+
+import "testing"
+
+func TestGoKeywordsTypesAndBuiltins(t *testing.T) {
+	expectTokens(t, Go, []string{
+		"func f(n int) error { return nil }",
+	}, [][]string{
+		{"Keyword(func)", "Punctuation(()", "Type(int)", "Punctuation())", "Type(error)",
+			"Punctuation({)", "Keyword(return)", "Builtin(nil)", "Punctuation(})"},
+	})
+}
+
+func TestGoRawStringAcrossLines(t *testing.T) {
+	expectTokens(t, Go, []string{
+		"s := `a",
+		"b // not a comment",
+		"c` + x // done",
+	}, [][]string{
+		{"Operator(:)", "Operator(=)", "String(`a)"},
+		{"String(b // not a comment)"},
+		{"String(c`)", "Operator(+)", "Comment(// done)"},
+	})
+}
+
+func TestGoBlockCommentAcrossLines(t *testing.T) {
+	expectTokens(t, Go, []string{
+		`x /* a "b`,
+		"still `here`",
+		"*/ y := 1.5e3",
+		`z := "/* not a comment */"`,
+	}, [][]string{
+		{`Comment(/* a "b)`},
+		{"Comment(still `here`)"},
+		{"Comment(*/)", "Operator(:)", "Operator(=)", "Number(1.5e3)"},
+		{"Operator(:)", "Operator(=)", `String("/* not a comment */")`},
+	})
+}
@@ -0,0 +1,344 @@
+// Package highlight adds syntax highlighting to fltk_bridge text widgets.
+//
+// A Lexer splits single lines into tokens; a Highlighter keeps the style
+// buffer of a TextDisplay in sync with its text buffer, re-lexing only the
+// lines touched by each edit plus the following lines whose lexer state
+// changed as a result (e.g. after opening a block comment).
+package highlight
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+)
+
+// Kind is the syntactic category of a token. Each kind is drawn with the
+// StyleTableEntry the Theme maps it to.
+type Kind int
+
+const (
+	Plain Kind = iota
+	Comment
+	Keyword
+	Type
+	Builtin
+	String
+	Number
+	Operator
+	Punctuation
+	Key
+	Section
+	Variable
+	Heading
+	Emphasis
+	Strong
+	Code
+	Link
+	Quote
+	Error
+	numKinds
+)
+
+// Token marks the bytes [Start, End) of a line as being of the given Kind.
+// Bytes not covered by any token are Plain.
+type Token struct {
+	Start, End int
+	Kind       Kind
+}
+
+// State carries what a lexer needs to know about the previous lines, such as
+// being inside a block comment. The first line is lexed with state 0.
+type State int
+
+// Lexer tokenizes one line at a time. line does not include the newline.
+// The returned State is passed back when lexing the next line; equal states
+// must mean equal lexing of what follows, which is what lets the Highlighter
+// stop re-lexing early.
+type Lexer interface {
+	Lex(line []byte, state State) ([]Token, State)
+}
+
+// LexerFunc adapts a function to the Lexer interface.
+type LexerFunc func(line []byte, state State) ([]Token, State)
+
+func (f LexerFunc) Lex(line []byte, state State) ([]Token, State) {
+	return f(line, state)
+}
+
+var (
+	lexers     = map[string]Lexer{}
+	extensions = map[string]string{}
+)
+
+// Register makes l available under name to Lookup, and to ForFile for
+// file names ending in one of exts (e.g. ".go").
+func Register(name string, l Lexer, exts ...string) {
+	lexers[name] = l
+	for _, ext := range exts {
+		extensions[strings.ToLower(ext)] = name
+	}
+}
+
+// Lookup returns the lexer registered under name, or nil.
+func Lookup(name string) Lexer {
+	return lexers[name]
+}
+
+// ForFile returns the lexer registered for the extension of filename, or nil.
+func ForFile(filename string) Lexer {
+	name, ok := extensions[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		return nil
+	}
+	return lexers[name]
+}
+
+func init() {
+	Register("go", Go, ".go")
+	Register("json", JSON, ".json")
+	Register("yaml", YAML, ".yaml", ".yml")
+	Register("ini", INI, ".ini", ".cfg", ".conf")
+	Register("shell", Shell, ".sh", ".bash", ".zsh")
+	Register("markdown", Markdown, ".md", ".markdown")
+}
+
+// Theme maps token kinds to the way they are drawn. Kinds missing from the
+// theme are drawn like Plain.
+type Theme map[Kind]fltk_bridge.StyleTableEntry
+
+func (t Theme) entries() []fltk_bridge.StyleTableEntry {
+	plain, ok := t[Plain]
+	if !ok {
+		plain = fltk_bridge.StyleTableEntry{Color: fltk_bridge.FOREGROUND_COLOR, Font: fltk_bridge.COURIER, Size: 14}
+	}
+	entries := make([]fltk_bridge.StyleTableEntry, numKinds)
+	for k := range entries {
+		if e, ok := t[Kind(k)]; ok {
+			entries[k] = e
+		} else {
+			entries[k] = plain
+		}
+	}
+	return entries
+}
+
+// LightTheme returns a theme for light backgrounds using Courier at size.
+func LightTheme(size int) Theme {
+	e := func(c fltk_bridge.Color, f fltk_bridge.Font) fltk_bridge.StyleTableEntry {
+		return fltk_bridge.StyleTableEntry{Color: c, Font: f, Size: size}
+	}
+	return Theme{
+		Plain:       e(fltk_bridge.BLACK, fltk_bridge.COURIER),
+		Comment:     e(fltk_bridge.ColorFromRgb(0x6a, 0x73, 0x7d), fltk_bridge.COURIER_ITALIC),
+		Keyword:     e(fltk_bridge.ColorFromRgb(0xa0, 0x1d, 0x6f), fltk_bridge.COURIER_BOLD),
+		Type:        e(fltk_bridge.ColorFromRgb(0x00, 0x5c, 0xc5), fltk_bridge.COURIER),
+		Builtin:     e(fltk_bridge.ColorFromRgb(0x00, 0x5c, 0xc5), fltk_bridge.COURIER),
+		String:      e(fltk_bridge.ColorFromRgb(0x03, 0x2f, 0x62), fltk_bridge.COURIER),
+		Number:      e(fltk_bridge.ColorFromRgb(0x00, 0x5c, 0xc5), fltk_bridge.COURIER),
+		Operator:    e(fltk_bridge.ColorFromRgb(0xd7, 0x3a, 0x49), fltk_bridge.COURIER),
+		Punctuation: e(fltk_bridge.ColorFromRgb(0x58, 0x60, 0x69), fltk_bridge.COURIER),
+		Key:         e(fltk_bridge.ColorFromRgb(0x22, 0x86, 0x3a), fltk_bridge.COURIER),
+		Section:     e(fltk_bridge.ColorFromRgb(0x6f, 0x42, 0xc1), fltk_bridge.COURIER_BOLD),
+		Variable:    e(fltk_bridge.ColorFromRgb(0xe3, 0x62, 0x09), fltk_bridge.COURIER),
+		Heading:     e(fltk_bridge.ColorFromRgb(0x00, 0x5c, 0xc5), fltk_bridge.COURIER_BOLD),
+		Emphasis:    e(fltk_bridge.BLACK, fltk_bridge.COURIER_ITALIC),
+		Strong:      e(fltk_bridge.BLACK, fltk_bridge.COURIER_BOLD),
+		Code:        e(fltk_bridge.ColorFromRgb(0x03, 0x2f, 0x62), fltk_bridge.COURIER),
+		Link:        e(fltk_bridge.ColorFromRgb(0x03, 0x66, 0xd6), fltk_bridge.COURIER),
+		Quote:       e(fltk_bridge.ColorFromRgb(0x6a, 0x73, 0x7d), fltk_bridge.COURIER_ITALIC),
+		Error:       e(fltk_bridge.RED, fltk_bridge.COURIER_BOLD),
+	}
+}
+
+// DarkTheme returns a theme for dark backgrounds using Courier at size.
+func DarkTheme(size int) Theme {
+	e := func(c fltk_bridge.Color, f fltk_bridge.Font) fltk_bridge.StyleTableEntry {
+		return fltk_bridge.StyleTableEntry{Color: c, Font: f, Size: size}
+	}
+	return Theme{
+		Plain:       e(fltk_bridge.ColorFromRgb(0xd4, 0xd4, 0xd4), fltk_bridge.COURIER),
+		Comment:     e(fltk_bridge.ColorFromRgb(0x6a, 0x99, 0x55), fltk_bridge.COURIER_ITALIC),
+		Keyword:     e(fltk_bridge.ColorFromRgb(0xc5, 0x86, 0xc0), fltk_bridge.COURIER_BOLD),
+		Type:        e(fltk_bridge.ColorFromRgb(0x4e, 0xc9, 0xb0), fltk_bridge.COURIER),
+		Builtin:     e(fltk_bridge.ColorFromRgb(0xdc, 0xdc, 0xaa), fltk_bridge.COURIER),
+		String:      e(fltk_bridge.ColorFromRgb(0xce, 0x91, 0x78), fltk_bridge.COURIER),
+		Number:      e(fltk_bridge.ColorFromRgb(0xb5, 0xce, 0xa8), fltk_bridge.COURIER),
+		Operator:    e(fltk_bridge.ColorFromRgb(0xd4, 0xd4, 0xd4), fltk_bridge.COURIER),
+		Punctuation: e(fltk_bridge.ColorFromRgb(0x80, 0x80, 0x80), fltk_bridge.COURIER),
+		Key:         e(fltk_bridge.ColorFromRgb(0x9c, 0xdc, 0xfe), fltk_bridge.COURIER),
+		Section:     e(fltk_bridge.ColorFromRgb(0x56, 0x9c, 0xd6), fltk_bridge.COURIER_BOLD),
+		Variable:    e(fltk_bridge.ColorFromRgb(0x9c, 0xdc, 0xfe), fltk_bridge.COURIER),
+		Heading:     e(fltk_bridge.ColorFromRgb(0x56, 0x9c, 0xd6), fltk_bridge.COURIER_BOLD),
+		Emphasis:    e(fltk_bridge.ColorFromRgb(0xd4, 0xd4, 0xd4), fltk_bridge.COURIER_ITALIC),
+		Strong:      e(fltk_bridge.ColorFromRgb(0xd4, 0xd4, 0xd4), fltk_bridge.COURIER_BOLD),
+		Code:        e(fltk_bridge.ColorFromRgb(0xce, 0x91, 0x78), fltk_bridge.COURIER),
+		Link:        e(fltk_bridge.ColorFromRgb(0x37, 0x94, 0xff), fltk_bridge.COURIER),
+		Quote:       e(fltk_bridge.ColorFromRgb(0x6a, 0x99, 0x55), fltk_bridge.COURIER_ITALIC),
+		Error:       e(fltk_bridge.ColorFromRgb(0xf4, 0x47, 0x47), fltk_bridge.COURIER_BOLD),
+	}
+}
+
+// styleChar is the style buffer byte for kind; SetHighlightData maps 'A' to
+// the first table entry.
+func styleChar(k Kind) byte {
+	return 'A' + byte(k)
+}
+
+// Highlighter styles the text of a TextDisplay with a Lexer and a Theme.
+type Highlighter struct {
+	display *fltk_bridge.TextDisplay
+	text    *fltk_bridge.TextBuffer
+	style   *fltk_bridge.TextBuffer
+	lexer   Lexer
+	theme   Theme
+	// states[i] is the lexer state at the end of line i.
+	states []State
+	handle fltk_bridge.TextCallbackHandle
+}
+
+// Attach starts highlighting text, which must be the buffer shown by display.
+func Attach(display *fltk_bridge.TextDisplay, text *fltk_bridge.TextBuffer, lexer Lexer, theme Theme) *Highlighter {
+	h := &Highlighter{
+		display: display,
+		text:    text,
+		style:   fltk_bridge.NewTextBuffer(),
+		lexer:   lexer,
+		theme:   theme,
+	}
+	display.SetHighlightData(h.style, theme.entries())
+	h.Refresh()
+	h.handle = text.AddModifyCallback(h.onModify)
+	return h
+}
+
+// Detach stops highlighting and frees the style buffer.
+func (h *Highlighter) Detach() {
+	h.text.RemoveModifyCallback(h.handle)
+	h.display.ClearHighlightData()
+	h.style.Destroy()
+	h.display.Redraw()
+}
+
+func (h *Highlighter) Lexer() Lexer { return h.lexer }
+
+// SetLexer switches to another language and restyles the whole text.
+func (h *Highlighter) SetLexer(l Lexer) {
+	h.lexer = l
+	h.Refresh()
+}
+
+func (h *Highlighter) Theme() Theme { return h.theme }
+
+func (h *Highlighter) SetTheme(t Theme) {
+	h.theme = t
+	h.display.SetHighlightData(h.style, t.entries())
+	h.display.Redraw()
+}
+
+// StyleBuffer returns the buffer holding one style byte per text byte.
+func (h *Highlighter) StyleBuffer() *fltk_bridge.TextBuffer { return h.style }
+
+// KindAt returns the kind of the token covering byte position pos.
+func (h *Highlighter) KindAt(pos int) Kind {
+	if pos < 0 || pos >= h.style.Length() {
+		return Plain
+	}
+	return Kind(h.style.CharAt(pos) - 'A')
+}
+
+// Refresh re-lexes the whole text, e.g. after the lexer changed its rules.
+func (h *Highlighter) Refresh() {
+	lines := strings.Split(h.text.Text(), "\n")
+	h.states = make([]State, len(lines))
+	var style strings.Builder
+	style.Grow(h.text.Length())
+	var state State
+	for i, line := range lines {
+		if i > 0 {
+			style.WriteByte(styleChar(Plain))
+		}
+		var tokens []Token
+		tokens, state = h.lexer.Lex([]byte(line), state)
+		style.Write(styleBytes(len(line), tokens))
+		h.states[i] = state
+	}
+	h.style.SetText(style.String())
+	h.display.Redraw()
+}
+
+func (h *Highlighter) onModify(pos, nInserted, nDeleted, nRestyled int, deletedText string) {
+	if nInserted == 0 && nDeleted == 0 {
+		return
+	}
+	// Keep the style buffer the same length as the text; relex fills in
+	// the real styles.
+	if nDeleted > 0 {
+		h.style.Remove(pos, pos+nDeleted)
+	}
+	if nInserted > 0 {
+		h.style.Insert(pos, strings.Repeat(string(styleChar(Plain)), nInserted))
+	}
+
+	line := h.text.CountLines(0, pos)
+	removed := strings.Count(deletedText, "\n")
+	added := h.text.CountLines(pos, pos+nInserted)
+	h.spliceStates(line, removed, added)
+	h.relex(line, h.text.LineStart(pos), line+added)
+}
+
+// spliceStates replaces the states of lines line..line+removed by added+1
+// ones for the lines that now stand in their place. The last of them ends
+// where the last removed line did, so it keeps that line's old state for
+// relex to compare against.
+func (h *Highlighter) spliceStates(line, removed, added int) {
+	if line+removed >= len(h.states) {
+		removed = len(h.states) - 1 - line
+	}
+	end := h.states[line+removed]
+	tail := h.states[line+removed+1:]
+	states := make([]State, 0, line+added+1+len(tail))
+	states = append(states, h.states[:line]...)
+	states = append(states, make([]State, added+1)...)
+	states[line+added] = end
+	h.states = append(states, tail...)
+}
+
+// relex lexes from line first, which starts at byte pos, through line last
+// and then on until a line ends in the same state as before.
+func (h *Highlighter) relex(first, pos, last int) {
+	var state State
+	if first > 0 {
+		state = h.states[first-1]
+	}
+	length := h.text.Length()
+	start := pos
+	for i := first; ; i++ {
+		end := h.text.LineEnd(pos)
+		line := h.text.GetTextRange(pos, end)
+		tokens, next := h.lexer.Lex([]byte(line), state)
+		h.style.ReplaceRange(pos, end, string(styleBytes(len(line), tokens)))
+		old := h.states[i]
+		h.states[i] = next
+		state = next
+		if end >= length || (i >= last && old == next) {
+			h.display.RedisplayRange(start, end)
+			return
+		}
+		pos = end + 1
+	}
+}
+
+func styleBytes(n int, tokens []Token) []byte {
+	style := make([]byte, n)
+	for i := range style {
+		style[i] = styleChar(Plain)
+	}
+	for _, t := range tokens {
+		start, end := max(t.Start, 0), min(t.End, n)
+		for i := start; i < end; i++ {
+			style[i] = styleChar(t.Kind)
+		}
+	}
+	return style
+}
@@ -0,0 +1,53 @@
+package highlight
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+)
+
+func TestBlockCommentOpenerInsertedAndDeleted(t *testing.T) {
+	text := fltk_bridge.NewTextBuffer()
+	display := fltk_bridge.NewTextDisplay(0, 0, 200, 200)
+	display.SetBuffer(text)
+	text.SetText("x\na\nb */\nc")
+	h := Attach(display, text, Go, LightTheme(14))
+	defer h.Detach()
+
+	// kindOf returns the kind of the first byte of s in the text.
+	kindOf := func(s string) Kind {
+		pos := strings.Index(text.Text(), s)
+		if pos < 0 {
+			t.Fatalf("%q not found in %q", s, text.Text())
+		}
+		return h.KindAt(pos)
+	}
+	expect := func(step string, comment, plain []string) {
+		t.Helper()
+		for _, s := range comment {
+			if k := kindOf(s); k != Comment {
+				t.Errorf("%s: %q is kind %d, want Comment", step, s, k)
+			}
+		}
+		for _, s := range plain {
+			if k := kindOf(s); k == Comment {
+				t.Errorf("%s: %q is still a Comment", step, s)
+			}
+		}
+	}
+
+	expect("initial", nil, []string{"x", "a", "c"})
+
+	text.Insert(0, "/*")
+	expect("opener inserted", []string{"x", "a", "b"}, []string{"c"})
+
+	text.Remove(0, 2)
+	expect("opener deleted", nil, []string{"x", "a", "c"})
+
+	text.Insert(0, "/*\n")
+	expect("opener line inserted", []string{"x", "a", "b"}, []string{"c"})
+
+	text.Remove(0, 3)
+	expect("opener line deleted", nil, []string{"x", "a", "c"})
+}
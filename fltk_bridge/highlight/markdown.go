@@ -0,0 +1,167 @@
+package highlight
+
+import "bytes"
+
+// Markdown highlights CommonMark text: headings, quotes, lists, fenced code
+// blocks and inline code, emphasis and links.
+var Markdown Lexer = LexerFunc(lexMarkdown)
+
+const (
+	mdNormal State = iota
+	mdBacktickFence
+	mdTildeFence
+)
+
+func lexMarkdown(line []byte, state State) ([]Token, State) {
+	var toks tokens
+	i := skipSpace(line, 0)
+	rest := line[i:]
+	if state != mdNormal {
+		toks.add(0, len(line), Code)
+		if (state == mdBacktickFence && bytes.HasPrefix(rest, []byte("```"))) ||
+			(state == mdTildeFence && bytes.HasPrefix(rest, []byte("~~~"))) {
+			return toks, mdNormal
+		}
+		return toks, state
+	}
+	switch {
+	case i >= 4:
+		toks.add(0, len(line), Code)
+		return toks, mdNormal
+	case bytes.HasPrefix(rest, []byte("```")):
+		toks.add(0, len(line), Code)
+		return toks, mdBacktickFence
+	case bytes.HasPrefix(rest, []byte("~~~")):
+		toks.add(0, len(line), Code)
+		return toks, mdTildeFence
+	case isMarkdownHeading(rest):
+		toks.add(i, len(line), Heading)
+		return toks, mdNormal
+	case len(rest) > 0 && rest[0] == '>':
+		toks.add(i, len(line), Quote)
+		return toks, mdNormal
+	case isMarkdownRule(rest):
+		toks.add(i, len(line), Punctuation)
+		return toks, mdNormal
+	}
+	if end := markdownListMarker(line, i); end > i {
+		toks.add(i, end, Punctuation)
+		i = end
+	}
+	lexMarkdownInline(&toks, line, i)
+	return toks, mdNormal
+}
+
+func isMarkdownHeading(s []byte) bool {
+	n := 0
+	for n < len(s) && s[n] == '#' {
+		n++
+	}
+	return n >= 1 && n <= 6 && (n == len(s) || isSpace(s[n]))
+}
+
+func isMarkdownRule(s []byte) bool {
+	if len(s) == 0 || bytes.IndexByte([]byte("-*_"), s[0]) < 0 {
+		return false
+	}
+	n := 0
+	for _, c := range s {
+		switch {
+		case c == s[0]:
+			n++
+		case !isSpace(c):
+			return false
+		}
+	}
+	return n >= 3
+}
+
+// markdownListMarker returns the end of a bullet or ordered list marker at i,
+// or i if there is none.
+func markdownListMarker(line []byte, i int) int {
+	if i+1 < len(line) && bytes.IndexByte([]byte("-*+"), line[i]) >= 0 && isSpace(line[i+1]) {
+		return i + 1
+	}
+	j := i
+	for j < len(line) && isDigit(line[j]) {
+		j++
+	}
+	if j > i && j+1 < len(line) && (line[j] == '.' || line[j] == ')') && isSpace(line[j+1]) {
+		return j + 1
+	}
+	return i
+}
+
+func lexMarkdownInline(toks *tokens, line []byte, i int) {
+	for i < len(line) {
+		c := line[i]
+		switch {
+		case c == '\\':
+			i += 2
+		case c == '`':
+			run := i
+			for run < len(line) && line[run] == '`' {
+				run++
+			}
+			fence := string(line[i:run])
+			end := scanUntil(line, run, fence)
+			if end < 0 {
+				i = run
+				continue
+			}
+			toks.add(i, end, Code)
+			i = end
+		case c == '*' || c == '_':
+			if c == '_' && i > 0 && isIdent(line[i-1]) {
+				i++
+				continue
+			}
+			delim, kind := string(c), Emphasis
+			if i+1 < len(line) && line[i+1] == c {
+				delim, kind = string([]byte{c, c}), Strong
+			}
+			end := scanUntil(line, i+len(delim), delim)
+			if end < 0 || end == i+2*len(delim) {
+				i += len(delim)
+				continue
+			}
+			toks.add(i, end, kind)
+			i = end
+		case c == '[' || c == '!' && i+1 < len(line) && line[i+1] == '[':
+			if end := markdownLinkEnd(line, i); end > 0 {
+				toks.add(i, end, Link)
+				i = end
+				continue
+			}
+			i++
+		case c == '<' && (bytes.HasPrefix(line[i+1:], []byte("http://")) || bytes.HasPrefix(line[i+1:], []byte("https://"))):
+			end := scanUntil(line, i, ">")
+			if end < 0 {
+				end = len(line)
+			}
+			toks.add(i, end, Link)
+			i = end
+		default:
+			i++
+		}
+	}
+}
+
+// markdownLinkEnd returns the end of [text](url) or [text][ref] starting at
+// i, or -1.
+func markdownLinkEnd(line []byte, i int) int {
+	if line[i] == '!' {
+		i++
+	}
+	end := scanUntil(line, i+1, "]")
+	if end < 0 || end >= len(line) {
+		return -1
+	}
+	switch line[end] {
+	case '(':
+		return scanUntil(line, end, ")")
+	case '[':
+		return scanUntil(line, end, "]")
+	}
+	return -1
+}
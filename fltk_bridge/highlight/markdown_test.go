@@ -0,0 +1,43 @@
+package highlight
+
+import "testing"
+
+func TestMarkdownFences(t *testing.T) {
+	expectTokens(t, Markdown, []string{
+		"```go",
+		"# not a heading",
+		"~~~",
+		"```",
+		"# Heading",
+		"~~~",
+		"```",
+		"~~~",
+		"*em* and `code`",
+	}, [][]string{
+		{"Code(```go)"},
+		{"Code(# not a heading)"},
+		{"Code(~~~)"},
+		{"Code(```)"},
+		{"Heading(# Heading)"},
+		{"Code(~~~)"},
+		{"Code(```)"},
+		{"Code(~~~)"},
+		{"Emphasis(*em*)", "Code(`code`)"},
+	})
+}
+
+func TestMarkdownBlocksAndInline(t *testing.T) {
+	expectTokens(t, Markdown, []string{
+		"> quoted *text*",
+		"- item with **bold** and [a link](http://x)",
+		"1. snake_case_word",
+		"    indented code",
+		"---",
+	}, [][]string{
+		{"Quote(> quoted *text*)"},
+		{"Punctuation(-)", "Strong(**bold**)", "Link([a link](http://x))"},
+		{"Punctuation(1.)"},
+		{"Code(    indented code)"},
+		{"Punctuation(---)"},
+	})
+}
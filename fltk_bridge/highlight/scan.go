@@ -0,0 +1,88 @@
+package highlight
+
+import "bytes"
+
+// tokens collects the tokens of one line.
+type tokens []Token
+
+func (t *tokens) add(start, end int, k Kind) {
+	if end > start {
+		*t = append(*t, Token{Start: start, End: end, Kind: k})
+	}
+}
+
+func isSpace(c byte) bool { return c == ' ' || c == '\t' || c == '\r' }
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// isIdentStart accepts any non-ASCII byte so that UTF-8 identifiers are
+// kept in one piece.
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+func isIdent(c byte) bool { return isIdentStart(c) || isDigit(c) }
+
+func skipSpace(line []byte, i int) int {
+	for i < len(line) && isSpace(line[i]) {
+		i++
+	}
+	return i
+}
+
+func scanIdent(line []byte, i int) int {
+	for i < len(line) && isIdent(line[i]) {
+		i++
+	}
+	return i
+}
+
+// scanNumber accepts decimal, hex, octal and binary literals with digit
+// separators, fractions and exponents. It does not validate them.
+func scanNumber(line []byte, i int) int {
+	for i < len(line) {
+		c := line[i]
+		switch {
+		case isIdent(c) || c == '.':
+			i++
+		case (c == '+' || c == '-') && i > 0 && bytes.IndexByte([]byte("eEpP"), line[i-1]) >= 0:
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// scanQuoted returns the end of the string starting with the quote at i and
+// whether it was closed on this line. With escapes, a backslash protects the
+// next byte.
+func scanQuoted(line []byte, i int, escapes bool) (int, bool) {
+	quote := line[i]
+	for i++; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if escapes {
+				i++
+			}
+		case quote:
+			return i + 1, true
+		}
+	}
+	return len(line), false
+}
+
+// scanUntil returns the index just past the first occurrence of end at or
+// after i, or -1.
+func scanUntil(line []byte, i int, end string) int {
+	if j := bytes.Index(line[i:], []byte(end)); j >= 0 {
+		return i + j + len(end)
+	}
+	return -1
+}
+
+func wordSet(words ...string) map[string]bool {
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		m[w] = true
+	}
+	return m
+}
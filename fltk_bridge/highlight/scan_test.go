@@ -0,0 +1,33 @@
+package highlight
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var kindNames = map[Kind]string{
+	Plain: "Plain", Comment: "Comment", Keyword: "Keyword", Type: "Type", Builtin: "Builtin",
+	String: "String", Number: "Number", Operator: "Operator", Punctuation: "Punctuation",
+	Key: "Key", Section: "Section", Variable: "Variable", Heading: "Heading",
+	Emphasis: "Emphasis", Strong: "Strong", Code: "Code", Link: "Link", Quote: "Quote",
+	Error: "Error",
+}
+
+// expectTokens lexes lines one after the other, starting with state 0, and
+// checks the tokens of each, written as Kind(text).
+func expectTokens(t *testing.T, l Lexer, lines []string, want [][]string) {
+	t.Helper()
+	var state State
+	for n, line := range lines {
+		var toks []Token
+		toks, state = l.Lex([]byte(line), state)
+		got := []string{}
+		for _, tok := range toks {
+			got = append(got, fmt.Sprintf("%s(%s)", kindNames[tok.Kind], line[tok.Start:tok.End]))
+		}
+		if !reflect.DeepEqual(got, want[n]) {
+			t.Errorf("line %d %q: tokens %q, want %q", n, line, got, want[n])
+		}
+	}
+}
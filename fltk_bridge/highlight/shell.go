@@ -0,0 +1,269 @@
+package highlight
+
+import (
+	"bytes"
+	"sync"
+)
+
+// Shell highlights POSIX shell and bash scripts. Quoted strings may span
+// several lines, and the body of a here-document is shown as a string up to
+// its delimiter line. Only the first here-document started on a line is
+// followed.
+var Shell Lexer = LexerFunc(lexShell)
+
+const (
+	shellNormal State = iota
+	shellDouble
+	shellSingle
+	// shellHeredoc+n is the state inside the body of shellHeredocs.list[n].
+	shellHeredoc
+)
+
+// heredoc is what tells the body of a here-document apart: the delimiter
+// ending it, whether it was started with <<-, which strips leading tabs,
+// and whether its delimiter was quoted, which turns off expansions.
+type heredoc struct {
+	delim  string
+	dash   bool
+	quoted bool
+}
+
+// shellHeredocs numbers the here-documents seen, so that a state, which is
+// an int, can stand for one.
+var shellHeredocs struct {
+	sync.Mutex
+	ids  map[heredoc]State
+	list []heredoc
+}
+
+func heredocState(doc heredoc) State {
+	shellHeredocs.Lock()
+	defer shellHeredocs.Unlock()
+	if id, ok := shellHeredocs.ids[doc]; ok {
+		return id
+	}
+	if shellHeredocs.ids == nil {
+		shellHeredocs.ids = map[heredoc]State{}
+	}
+	id := shellHeredoc + State(len(shellHeredocs.list))
+	shellHeredocs.ids[doc] = id
+	shellHeredocs.list = append(shellHeredocs.list, doc)
+	return id
+}
+
+func heredocOf(state State) heredoc {
+	shellHeredocs.Lock()
+	defer shellHeredocs.Unlock()
+	return shellHeredocs.list[state-shellHeredoc]
+}
+
+var (
+	shellKeywords = wordSet("if", "then", "else", "elif", "fi", "for", "while", "until", "do",
+		"done", "case", "esac", "in", "function", "select", "time", "return", "break", "continue")
+	shellBuiltins = wordSet("alias", "cd", "declare", "echo", "eval", "exec", "exit", "export",
+		"local", "printf", "read", "readonly", "set", "shift", "source", "test", "trap",
+		"unset", "wait")
+)
+
+func lexShell(line []byte, state State) ([]Token, State) {
+	var toks tokens
+	i := 0
+	switch {
+	case state == shellDouble || state == shellSingle:
+		end, closed := scanShellString(line, 0, state)
+		toks.add(0, end, String)
+		if !closed {
+			return toks, state
+		}
+		i = end
+	case state >= shellHeredoc:
+		return lexHeredocLine(line, state)
+	}
+	// next is the state of the next line, in the body of a here-document
+	// once one was started.
+	next := shellNormal
+	for i < len(line) {
+		c := line[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == '#' && (i == 0 || isSpace(line[i-1]) || line[i-1] == ';'):
+			toks.add(i, len(line), Comment)
+			return toks, next
+		case bytes.HasPrefix(line[i:], []byte("<<<")):
+			toks.add(i, i+3, Operator)
+			i += 3
+		case bytes.HasPrefix(line[i:], []byte("<<")):
+			op, end, doc, ok := scanHeredoc(line, i)
+			toks.add(i, op, Operator)
+			if ok {
+				toks.add(op, end, String)
+				if next == shellNormal {
+					next = heredocState(doc)
+				}
+			}
+			i = end
+		case c == '"' || c == '\'':
+			quoted := shellDouble
+			if c == '\'' {
+				quoted = shellSingle
+			}
+			end, closed := scanShellString(line, i+1, quoted)
+			toks.add(i, end, String)
+			if !closed {
+				return toks, quoted
+			}
+			i = end
+		case c == '\\':
+			i += 2
+		case c == '$':
+			end := scanShellVariable(line, i)
+			toks.add(i, end, Variable)
+			i = end
+		case isIdentStart(c) || isDigit(c) || c == '-' || c == '.' || c == '/':
+			end := i
+			for end < len(line) && (isIdent(line[end]) || bytes.IndexByte([]byte("-./+,@%"), line[end]) >= 0) {
+				end++
+			}
+			word := string(line[i:end])
+			switch {
+			case shellKeywords[word]:
+				toks.add(i, end, Keyword)
+			case shellBuiltins[word]:
+				toks.add(i, end, Builtin)
+			case end < len(line) && line[end] == '=' && isIdentStart(c):
+				toks.add(i, end, Variable)
+			case scanNumber(line, i) == end && isDigit(c):
+				toks.add(i, end, Number)
+			}
+			i = end
+		case bytes.IndexByte([]byte("|&;<>()!=`"), c) >= 0:
+			toks.add(i, i+1, Operator)
+			i++
+		case bytes.IndexByte([]byte("{}[]"), c) >= 0:
+			toks.add(i, i+1, Punctuation)
+			i++
+		default:
+			i++
+		}
+	}
+	return toks, next
+}
+
+// scanHeredoc scans the << or <<- operator at i and the delimiter word
+// following it, returning the end of the operator, the end of the word, and
+// the here-document they start if there is a word.
+func scanHeredoc(line []byte, i int) (op, end int, doc heredoc, ok bool) {
+	op = i + 2
+	if op < len(line) && line[op] == '-' {
+		doc.dash = true
+		op++
+	}
+	j := skipSpace(line, op)
+	if j == len(line) {
+		return op, op, doc, false
+	}
+	switch c := line[j]; c {
+	case '\'', '"':
+		k := bytes.IndexByte(line[j+1:], c)
+		if k < 0 {
+			return op, op, doc, false
+		}
+		doc.delim, doc.quoted = string(line[j+1:j+1+k]), true
+		return op, j + k + 2, doc, true
+	case '\\':
+		doc.quoted = true
+		j++
+	}
+	k := j
+	for k < len(line) && (isIdent(line[k]) || line[k] == '-' || line[k] == '.') {
+		k++
+	}
+	if k == j {
+		return op, op, doc, false
+	}
+	doc.delim = string(line[j:k])
+	return op, k, doc, true
+}
+
+// lexHeredocLine lexes a line of the body of a here-document or the
+// delimiter line ending it.
+func lexHeredocLine(line []byte, state State) ([]Token, State) {
+	var toks tokens
+	doc := heredocOf(state)
+	body := line
+	if doc.dash {
+		body = bytes.TrimLeft(body, "\t")
+	}
+	if string(body) == doc.delim {
+		toks.add(len(line)-len(body), len(line), Operator)
+		return toks, shellNormal
+	}
+	if doc.quoted {
+		toks.add(0, len(line), String)
+		return toks, state
+	}
+	start := 0
+	for i := 0; i < len(line); {
+		switch line[i] {
+		case '\\':
+			i += 2
+		case '$':
+			end := scanShellVariable(line, i)
+			toks.add(start, i, String)
+			toks.add(i, end, Variable)
+			i, start = end, end
+		default:
+			i++
+		}
+	}
+	toks.add(start, len(line), String)
+	return toks, state
+}
+
+// scanShellString scans the rest of a string from i and reports whether the
+// closing quote was found.
+func scanShellString(line []byte, i int, state State) (int, bool) {
+	quote := byte('"')
+	if state == shellSingle {
+		quote = '\''
+	}
+	for ; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i + 1, true
+		}
+	}
+	return len(line), false
+}
+
+// scanShellVariable returns the end of $name, ${...}, $(...), $1, $@ and
+// similar expansions starting at i.
+func scanShellVariable(line []byte, i int) int {
+	if i+1 >= len(line) {
+		return i + 1
+	}
+	switch c := line[i+1]; {
+	case c == '{' || c == '(':
+		closer := byte('}')
+		if c == '(' {
+			closer = ')'
+		}
+		if end := bytes.IndexByte(line[i+2:], closer); end >= 0 {
+			if c == '(' {
+				return i + 2
+			}
+			return i + 2 + end + 1
+		}
+		return len(line)
+	case isIdentStart(c):
+		return scanIdent(line, i+1)
+	case isDigit(c) || bytes.IndexByte([]byte("@*#?$!-"), c) >= 0:
+		return i + 2
+	}
+	return i + 1
+}
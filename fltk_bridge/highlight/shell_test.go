@@ -0,0 +1,73 @@
+package highlight
+
+import "testing"
+
+func TestShellQuoting(t *testing.T) {
+	expectTokens(t, Shell, []string{
+		`echo "a $b" 'c $d' # note`,
+		`x="one`,
+		`two \" still" y`,
+		`echo \"not a string a#b`,
+		`printf '%s' "${v}"; exit 1`,
+	}, [][]string{
+		{"Builtin(echo)", `String("a $b")`, "String('c $d')", "Comment(# note)"},
+		{"Variable(x)", "Operator(=)", `String("one)`},
+		{`String(two \" still")`},
+		{"Builtin(echo)"},
+		{"Builtin(printf)", "String('%s')", `String("${v}")`, "Operator(;)", "Builtin(exit)", "Number(1)"},
+	})
+}
+
+func TestShellHeredoc(t *testing.T) {
+	expectTokens(t, Shell, []string{
+		"cat <<EOF > out",
+		"if $x then",
+		`  "not closed`,
+		"EOF",
+		"echo finished",
+	}, [][]string{
+		{"Operator(<<)", "String(EOF)", "Operator(>)"},
+		{"String(if )", "Variable($x)", "String( then)"},
+		{`String(  "not closed)`},
+		{"Operator(EOF)"},
+		{"Builtin(echo)"},
+	})
+}
+
+func TestShellHeredocQuotedAndIndented(t *testing.T) {
+	expectTokens(t, Shell, []string{
+		"\tcat <<-'END' # comment",
+		"\t$HOME",
+		"  END",
+		"\tEND",
+		"fi",
+	}, [][]string{
+		{"Operator(<<-)", "String('END')", "Comment(# comment)"},
+		{"String(\t$HOME)"},
+		{"String(  END)"},
+		{"Operator(END)"},
+		{"Keyword(fi)"},
+	})
+}
+
+func TestShellHereString(t *testing.T) {
+	expectTokens(t, Shell, []string{
+		`read x <<< "$y"`,
+		"EOF",
+	}, [][]string{
+		{"Builtin(read)", "Operator(<<<)", `String("$y")`},
+		{},
+	})
+}
+
+func TestShellHeredocStates(t *testing.T) {
+	_, a := Shell.Lex([]byte("cat <<EOF"), 0)
+	_, b := Shell.Lex([]byte(`cat << "EOF"`), 0)
+	_, c := Shell.Lex([]byte("cat <<EOF"), 0)
+	if a == b {
+		t.Errorf("quoted and unquoted delimiters share state %d", a)
+	}
+	if a != c {
+		t.Errorf("the same here-document got states %d and %d", a, c)
+	}
+}
@@ -3,12 +3,20 @@
 #include <cstring>
 
 #include <FL/Fl_Image.H>
+#include <FL/Fl_Bitmap.H>
+#include <FL/Fl_Pixmap.H>
 #include <FL/Fl_BMP_Image.H>
 #include <FL/Fl_SVG_Image.H>  
 #include <FL/Fl_PNG_Image.H>  
 #include <FL/Fl_JPEG_Image.H> 
 #include <FL/Fl_RGB_Image.H> 
 #include <FL/Fl_Shared_Image.H>
+#include <FL/Fl_GIF_Image.H>
+#include <FL/Fl_Anim_GIF_Image.H>
+#include <FL/Fl_ICO_Image.H>
+#include <FL/Fl_XPM_Image.H>
+#include <FL/Fl_XBM_Image.H>
+#include <FL/Fl_PNM_Image.H>
 
 void go_fltk_image_draw(Fl_Image *i, int X, int Y, int W, int H) {
     return i->draw(X, Y, W, H);
@@ -55,7 +63,79 @@ int go_fltk_image_ld(const Fl_Image *i) {
 void go_fltk_image_inactive(Fl_Image *i) {
     return i->inactive();
 }
-  
+Fl_Image *go_fltk_image_copy_sized(Fl_Image *i, int W, int H) {
+    return i->copy(W, H);
+}
+Fl_Image *go_fltk_image_copy_scaled(Fl_Image *i, int W, int H, int scaling) {
+    Fl_RGB_Scaling old = Fl_Image::RGB_scaling();
+    Fl_Image::RGB_scaling((Fl_RGB_Scaling)scaling);
+    Fl_Image *copy = i->copy(W, H);
+    Fl_Image::RGB_scaling(old);
+    return copy;
+}
+void go_fltk_image_color_average(Fl_Image *i, unsigned int c, float f) {
+    i->color_average((Fl_Color)c, f);
+}
+void go_fltk_image_desaturate(Fl_Image *i) {
+    i->desaturate();
+}
+void go_fltk_image_set_rgb_scaling(int scaling) {
+    Fl_Image::RGB_scaling((Fl_RGB_Scaling)scaling);
+}
+int go_fltk_image_rgb_scaling(void) {
+    return Fl_Image::RGB_scaling();
+}
+void go_fltk_image_set_scaling_algorithm(int scaling) {
+    Fl_Image::scaling_algorithm((Fl_RGB_Scaling)scaling);
+}
+int go_fltk_image_scaling_algorithm(void) {
+    return Fl_Image::scaling_algorithm();
+}
+int go_fltk_shared_image_refcount(Fl_Shared_Image *i) {
+    return i->refcount();
+}
+
+// Shared images wrap the image that was actually loaded.
+static Fl_Image *base_image(Fl_Image *i) {
+    Fl_Shared_Image *shared = i->as_shared_image();
+    if (shared != NULL && shared->image() != NULL) {
+        return (Fl_Image *)shared->image();
+    }
+    return i;
+}
+
+const unsigned char *go_fltk_image_rgb_data(Fl_Image *i, int *w, int *h, int *d, int *ld) {
+    Fl_RGB_Image *rgb = dynamic_cast<Fl_RGB_Image *>(base_image(i));
+    if (rgb == NULL) {
+        return NULL;
+    }
+    // rasterizes images that are decoded lazily, e.g. SVG
+    rgb->normalize();
+    *w = rgb->data_w();
+    *h = rgb->data_h();
+    *d = rgb->d();
+    *ld = rgb->ld();
+    return rgb->array;
+}
+
+const unsigned char *go_fltk_image_bitmap_data(Fl_Image *i, int *w, int *h) {
+    Fl_Bitmap *bm = dynamic_cast<Fl_Bitmap *>(base_image(i));
+    if (bm == NULL) {
+        return NULL;
+    }
+    *w = bm->data_w();
+    *h = bm->data_h();
+    return bm->array;
+}
+
+Fl_RGB_Image *go_fltk_image_pixmap_to_rgb(Fl_Image *i) {
+    Fl_Pixmap *pxm = dynamic_cast<Fl_Pixmap *>(base_image(i));
+    if (pxm == NULL) {
+        return NULL;
+    }
+    return new Fl_RGB_Image(pxm);
+}
+
 Fl_SVG_Image *go_fltk_svg_image_load(const char *file) {
     return new Fl_SVG_Image(file);
 }
@@ -88,6 +168,116 @@ Fl_BMP_Image *go_fltk_bmp_image_data(const unsigned char *data, long size) {
     return new Fl_BMP_Image(NULL, data, size);
 }
 
+Fl_GIF_Image *go_fltk_gif_image_load(const char *file) {
+    return new Fl_GIF_Image(file);
+}
+
+Fl_GIF_Image *go_fltk_gif_image_data(const unsigned char *data, long size) {
+    return new Fl_GIF_Image(NULL, data, (size_t)size);
+}
+
+Fl_ICO_Image *go_fltk_ico_image_load(const char *file, int id) {
+    return new Fl_ICO_Image(file, id);
+}
+
+Fl_ICO_Image *go_fltk_ico_image_data(const unsigned char *data, long size, int id) {
+    return new Fl_ICO_Image(NULL, id, data, (size_t)size);
+}
+
+int go_fltk_ico_image_idcount(Fl_ICO_Image *self) {
+    return self->idcount();
+}
+
+void go_fltk_ico_image_icondirentry(Fl_ICO_Image *self, int i, int *w, int *h, int *colorCount, int *bitCount) {
+    const Fl_ICO_Image::IconDirEntry *e = self->icondirentry() + i;
+    // a stored width/height of 0 means 256 pixels
+    *w = e->bWidth ? e->bWidth : 256;
+    *h = e->bHeight ? e->bHeight : 256;
+    *colorCount = e->bColorCount;
+    *bitCount = e->wBitCount;
+}
+
+Fl_XPM_Image *go_fltk_xpm_image_load(const char *file) {
+    return new Fl_XPM_Image(file);
+}
+
+Fl_XBM_Image *go_fltk_xbm_image_load(const char *file) {
+    return new Fl_XBM_Image(file);
+}
+
+Fl_PNM_Image *go_fltk_pnm_image_load(const char *file) {
+    return new Fl_PNM_Image(file);
+}
+
+Fl_Anim_GIF_Image *go_fltk_anim_gif_image_load(const char *file, Fl_Widget *canvas, int flags) {
+    return new Fl_Anim_GIF_Image(file, canvas, (unsigned short)flags);
+}
+
+Fl_Anim_GIF_Image *go_fltk_anim_gif_image_data(const unsigned char *data, long size, Fl_Widget *canvas, int flags) {
+    return new Fl_Anim_GIF_Image(NULL, data, (size_t)size, canvas, (unsigned short)flags);
+}
+
+int go_fltk_anim_gif_image_start(Fl_Anim_GIF_Image *self) {
+    return self->start();
+}
+
+int go_fltk_anim_gif_image_stop(Fl_Anim_GIF_Image *self) {
+    return self->stop();
+}
+
+int go_fltk_anim_gif_image_next(Fl_Anim_GIF_Image *self) {
+    return self->next();
+}
+
+int go_fltk_anim_gif_image_playing(Fl_Anim_GIF_Image *self) {
+    return self->playing();
+}
+
+int go_fltk_anim_gif_image_is_animated(Fl_Anim_GIF_Image *self) {
+    return self->is_animated();
+}
+
+double go_fltk_anim_gif_image_speed(Fl_Anim_GIF_Image *self) {
+    return self->speed();
+}
+
+void go_fltk_anim_gif_image_set_speed(Fl_Anim_GIF_Image *self, double speed) {
+    self->speed(speed);
+}
+
+int go_fltk_anim_gif_image_frames(Fl_Anim_GIF_Image *self) {
+    return self->frames();
+}
+
+int go_fltk_anim_gif_image_frame(Fl_Anim_GIF_Image *self) {
+    return self->frame();
+}
+
+void go_fltk_anim_gif_image_set_frame(Fl_Anim_GIF_Image *self, int frame) {
+    self->frame(frame);
+}
+
+Fl_Image *go_fltk_anim_gif_image_frame_image(Fl_Anim_GIF_Image *self, int frame) {
+    return self->image(frame);
+}
+
+double go_fltk_anim_gif_image_delay(Fl_Anim_GIF_Image *self, int frame) {
+    return self->delay(frame);
+}
+
+void go_fltk_anim_gif_image_set_delay(Fl_Anim_GIF_Image *self, int frame, double delay) {
+    self->delay(frame, delay);
+}
+
+void go_fltk_anim_gif_image_set_canvas(Fl_Anim_GIF_Image *self, Fl_Widget *canvas, int flags) {
+    self->canvas(canvas, (unsigned short)flags);
+}
+
+const int go_Fl_Anim_GIF_Image_DONT_START = Fl_Anim_GIF_Image::DONT_START;
+const int go_Fl_Anim_GIF_Image_DONT_RESIZE_CANVAS = Fl_Anim_GIF_Image::DONT_RESIZE_CANVAS;
+const int go_Fl_Anim_GIF_Image_DONT_SET_AS_IMAGE = Fl_Anim_GIF_Image::DONT_SET_AS_IMAGE;
+const int go_Fl_Anim_GIF_Image_OPTIMIZE_MEMORY = Fl_Anim_GIF_Image::OPTIMIZE_MEMORY;
+
 Fl_Shared_Image *go_fltk_shared_image_load(const char *file) {
     return Fl_Shared_Image::get(file, 0, 0);
 }
@@ -106,6 +296,9 @@ Fl_RGB_Image *go_fltk_rgb_image_data(const unsigned char *bits, int bitsLen, int
     return img;
 }
 
+const int go_FL_RGB_SCALING_NEAREST = FL_RGB_SCALING_NEAREST;
+const int go_FL_RGB_SCALING_BILINEAR = FL_RGB_SCALING_BILINEAR;
+
 const int go_Fl_Image_ERR_NO_IMAGE = Fl_Image::ERR_NO_IMAGE;
 const int go_Fl_Image_ERR_FILE_ACCESS = Fl_Image::ERR_FILE_ACCESS;
 const int go_Fl_Image_ERR_FORMAT = Fl_Image::ERR_FORMAT;
@@ -6,14 +6,32 @@ package fltk_bridge
 */
 import "C"
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	goimage "image"
+	"image/color"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 )
 
 type image struct {
 	iPtr *C.Fl_Image
+	// owned is false for images that belong to another FLTK object, such as
+	// the frames of an animated GIF; those are never freed from Go.
+	owned bool
+	// users counts the widgets currently displaying the image.
+	users int
+	// released is set when Destroy was called while widgets still used the
+	// image; it is then freed as soon as the last of them lets go of it.
+	released bool
 }
 
 type Image interface {
@@ -24,19 +42,99 @@ var ErrImageDestroyed = errors.New("image is destroyed")
 
 func (i *image) getImage() *image { return i }
 func (i *image) ptr() *C.Fl_Image {
-	if i.iPtr == nil {
+	if i.iPtr == nil || i.released {
 		panic(ErrImageDestroyed)
 	}
 	return i.iPtr
 }
 
+// initImage takes ownership of p. The image is freed by Destroy or, once
+// neither Go code nor any widget references it, by the garbage collector.
 func initImage(i Image, p unsafe.Pointer) {
+	releasePendingImages()
+	img := i.getImage()
+	img.iPtr = (*C.Fl_Image)(p)
+	if p == nil {
+		return
+	}
+	img.owned = true
+	liveImages.Add(1)
+	runtime.SetFinalizer(i, finalizeImage)
+}
+func initUnownedImage(i Image, p unsafe.Pointer) {
 	i.getImage().iPtr = (*C.Fl_Image)(p)
 }
 
+// Destroy frees the image. If widgets still display it, freeing is
+// postponed until they stop doing so; i must not be used either way. An
+// image owned by another object, such as a frame of an AnimGifImage, is
+// only let go of, and stays valid as long as its owner.
 func (i *image) Destroy() {
-	C.go_fltk_image_delete(i.ptr())
+	i.ptr()
+	if i.users > 0 {
+		i.released = true
+		return
+	}
+	i.free()
+}
+
+// free deletes an owned image and forgets an unowned one, which its owner
+// deletes.
+func (i *image) free() {
+	if i.owned {
+		liveImages.Add(-1)
+		C.go_fltk_image_delete(i.iPtr)
+	}
 	i.iPtr = nil
+	i.released = false
+}
+
+// retain and drop are called by widgets that start and stop displaying i.
+func (i *image) retain() {
+	i.users++
+}
+func (i *image) drop() {
+	i.users--
+	if i.users == 0 && i.released {
+		i.free()
+	}
+}
+
+var liveImages atomic.Int64
+
+// LiveImageCount returns the number of images created by this package that
+// have not been freed yet. It is meant for tracking down image leaks.
+func LiveImageCount() int {
+	return int(liveImages.Load())
+}
+
+// Finalizers run on their own goroutine while FLTK may only be used from the
+// UI thread, so collected images are queued and freed from there.
+var pendingImages struct {
+	sync.Mutex
+	ptrs []*C.Fl_Image
+}
+
+func finalizeImage(i Image) {
+	img := i.getImage()
+	if img.iPtr == nil {
+		return
+	}
+	pendingImages.Lock()
+	pendingImages.ptrs = append(pendingImages.ptrs, img.iPtr)
+	pendingImages.Unlock()
+	Awake(releasePendingImages)
+}
+
+func releasePendingImages() {
+	pendingImages.Lock()
+	ptrs := pendingImages.ptrs
+	pendingImages.ptrs = nil
+	pendingImages.Unlock()
+	for _, p := range ptrs {
+		C.go_fltk_image_delete(p)
+		liveImages.Add(-1)
+	}
 }
 
 func (i *image) Draw(x, y, w, h int) {
@@ -78,10 +176,50 @@ func (i *image) D() int {
 func (i *image) Ld() int {
 	return int(C.go_fltk_image_ld(i.ptr()))
 }
+
+// Inactive greys out the image in place, the way FLTK draws deactivated widgets.
 func (i *image) Inactive() {
 	C.go_fltk_image_inactive(i.ptr())
 }
 
+// ColorAverage blends the image in place with color c. weight is the share
+// of the original image, from 0 (only c) to 1 (unchanged).
+func (i *image) ColorAverage(c Color, weight float32) {
+	C.go_fltk_image_color_average(i.ptr(), C.uint(c), C.float(weight))
+}
+
+// Desaturate converts the image to grayscale in place.
+func (i *image) Desaturate() {
+	C.go_fltk_image_desaturate(i.ptr())
+}
+
+type ScalingAlgorithm int
+
+var (
+	ScalingNearest  = ScalingAlgorithm(C.go_FL_RGB_SCALING_NEAREST)
+	ScalingBilinear = ScalingAlgorithm(C.go_FL_RGB_SCALING_BILINEAR)
+)
+
+// SetRgbScaling sets the algorithm used when RgbImage.Copy resizes an image.
+// FLTK defaults to ScalingNearest.
+func SetRgbScaling(algorithm ScalingAlgorithm) {
+	C.go_fltk_image_set_rgb_scaling(C.int(algorithm))
+}
+
+func RgbScaling() ScalingAlgorithm {
+	return ScalingAlgorithm(C.go_fltk_image_rgb_scaling())
+}
+
+// SetImageScalingAlgorithm sets the algorithm used when drawing an image at a
+// size other than its pixel size, e.g. after Scale. FLTK defaults to ScalingBilinear.
+func SetImageScalingAlgorithm(algorithm ScalingAlgorithm) {
+	C.go_fltk_image_set_scaling_algorithm(C.int(algorithm))
+}
+
+func ImageScalingAlgorithm() ScalingAlgorithm {
+	return ScalingAlgorithm(C.go_fltk_image_scaling_algorithm())
+}
+
 var ErrNoImage = errors.New("no image was found")
 var ErrImageFileAccess = errors.New("image file access error")
 var ErrImageDecodingFailed = errors.New("image decoding failed")
@@ -163,6 +301,198 @@ func NewRgbImageFromImage(image goimage.Image) (*RgbImage, error) {
 	return rgbImage, nil
 }
 
+func wrapRgbImageCopy(p *C.Fl_Image) *RgbImage {
+	if p == nil {
+		return nil
+	}
+	img := &RgbImage{}
+	initImage(img, unsafe.Pointer(p))
+	return img
+}
+
+// copyTo makes dst a copy of i resized to w x h, of the same kind as i, and
+// reports whether it could. The copy is independent of i and is freed like
+// any other image.
+func (i *image) copyTo(dst Image, w, h int) bool {
+	p := C.go_fltk_image_copy_sized(i.ptr(), C.int(w), C.int(h))
+	if p == nil {
+		return false
+	}
+	initImage(dst, unsafe.Pointer(p))
+	return true
+}
+
+// Copy returns a new image resized to w x h with the algorithm set by
+// SetRgbScaling. The copy is independent of i.
+func (i *RgbImage) Copy(w, h int) *RgbImage {
+	img := &RgbImage{}
+	if !i.copyTo(img, w, h) {
+		return nil
+	}
+	return img
+}
+
+// Clone returns an exact, independent copy of the image pixels.
+func (i *RgbImage) Clone() *RgbImage {
+	return i.Copy(i.DataW(), i.DataH())
+}
+
+// InactiveCopy returns a greyed out copy, e.g. for SetDeimage, leaving i unchanged.
+func (i *RgbImage) InactiveCopy() *RgbImage {
+	img := i.Clone()
+	if img != nil {
+		img.Inactive()
+	}
+	return img
+}
+
+// DesaturatedCopy returns a grayscale copy, leaving i unchanged.
+func (i *RgbImage) DesaturatedCopy() *RgbImage {
+	img := i.Clone()
+	if img != nil {
+		img.Desaturate()
+	}
+	return img
+}
+
+// ColorAveragedCopy returns a copy blended with c, see ColorAverage.
+func (i *RgbImage) ColorAveragedCopy(c Color, weight float32) *RgbImage {
+	img := i.Clone()
+	if img != nil {
+		img.ColorAverage(c, weight)
+	}
+	return img
+}
+
+// ScaledCopy returns a copy resized to w x h with bilinear filtering,
+// regardless of SetRgbScaling.
+func (i *RgbImage) ScaledCopy(w, h int) *RgbImage {
+	return wrapRgbImageCopy(C.go_fltk_image_copy_scaled(i.ptr(), C.int(w), C.int(h), C.int(ScalingBilinear)))
+}
+
+// ScaledCopies returns square, bilinear scaled copies for each of the given
+// sizes, e.g. to build the variants passed to Window.SetIcons:
+//
+//	icons := logo.ScaledCopies(16, 32, 48, 256)
+func (i *RgbImage) ScaledCopies(sizes ...int) []*RgbImage {
+	copies := make([]*RgbImage, 0, len(sizes))
+	for _, size := range sizes {
+		if img := i.ScaledCopy(size, size); img != nil {
+			copies = append(copies, img)
+		}
+	}
+	return copies
+}
+
+// ToRGBA converts the image into a Go image. RGB based images (PNG, JPEG,
+// BMP, SVG, ...) are read directly, pixmaps (GIF, XPM) are rendered first
+// and bitmaps (XBM) become opaque black on a transparent background.
+// The pixel data is read at full resolution, ignoring any Scale.
+func (i *image) ToRGBA() (*goimage.RGBA, error) {
+	var w, h, d, ld C.int
+	if pix := C.go_fltk_image_rgb_data(i.ptr(), &w, &h, &d, &ld); pix != nil {
+		return rgbaFromPixels(pix, int(w), int(h), int(d), int(ld)), nil
+	}
+	if bits := C.go_fltk_image_bitmap_data(i.ptr(), &w, &h); bits != nil {
+		return rgbaFromBitmap(bits, int(w), int(h)), nil
+	}
+	if rgb := C.go_fltk_image_pixmap_to_rgb(i.ptr()); rgb != nil {
+		defer C.go_fltk_image_delete((*C.Fl_Image)(unsafe.Pointer(rgb)))
+		if pix := C.go_fltk_image_rgb_data((*C.Fl_Image)(unsafe.Pointer(rgb)), &w, &h, &d, &ld); pix != nil {
+			return rgbaFromPixels(pix, int(w), int(h), int(d), int(ld)), nil
+		}
+	}
+	return nil, ErrNoImage
+}
+
+// ImageToRGBA converts any image into a Go image, see ToRGBA.
+func ImageToRGBA(img Image) (*goimage.RGBA, error) {
+	return img.getImage().ToRGBA()
+}
+
+func rgbaFromPixels(pix *C.uchar, w, h, d, ld int) *goimage.RGBA {
+	out := goimage.NewRGBA(goimage.Rect(0, 0, w, h))
+	if w <= 0 || h <= 0 || d < 1 || d > 4 {
+		return out
+	}
+	if ld == 0 {
+		ld = w * d
+	}
+	src := unsafe.Slice((*uint8)(unsafe.Pointer(pix)), (h-1)*ld+w*d)
+	for y := 0; y < h; y++ {
+		row := src[y*ld : y*ld+w*d]
+		dst := out.Pix[y*out.Stride : y*out.Stride+w*4]
+		for x := 0; x < w; x++ {
+			r, g, b, a := pixelAt(row[x*d:x*d+d], d)
+			if a != 0xff {
+				r = uint8(uint16(r) * uint16(a) / 0xff)
+				g = uint8(uint16(g) * uint16(a) / 0xff)
+				b = uint8(uint16(b) * uint16(a) / 0xff)
+			}
+			dst[x*4], dst[x*4+1], dst[x*4+2], dst[x*4+3] = r, g, b, a
+		}
+	}
+	return out
+}
+
+// pixelAt decodes one non-premultiplied pixel of depth d.
+func pixelAt(p []uint8, d int) (r, g, b, a uint8) {
+	switch d {
+	case 1:
+		return p[0], p[0], p[0], 0xff
+	case 2:
+		return p[0], p[0], p[0], p[1]
+	case 3:
+		return p[0], p[1], p[2], 0xff
+	default:
+		return p[0], p[1], p[2], p[3]
+	}
+}
+
+func rgbaFromBitmap(bits *C.uchar, w, h int) *goimage.RGBA {
+	out := goimage.NewRGBA(goimage.Rect(0, 0, w, h))
+	if w <= 0 || h <= 0 {
+		return out
+	}
+	rowLen := (w + 7) / 8
+	src := unsafe.Slice((*uint8)(unsafe.Pointer(bits)), rowLen*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if src[y*rowLen+x/8]&(1<<(x%8)) != 0 {
+				out.Pix[y*out.Stride+x*4+3] = 0xff
+			}
+		}
+	}
+	return out
+}
+
+// ColorModel, Bounds and At implement image.Image, so RGB based images can
+// be handed to Go's image processing and encoders directly. For bulk access
+// ToRGBA is considerably faster than calling At for every pixel.
+func (i *RgbImage) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+func (i *RgbImage) Bounds() goimage.Rectangle {
+	return goimage.Rect(0, 0, i.DataW(), i.DataH())
+}
+
+func (i *RgbImage) At(x, y int) color.Color {
+	var w, h, d, ld C.int
+	pix := C.go_fltk_image_rgb_data(i.ptr(), &w, &h, &d, &ld)
+	if pix == nil || x < 0 || y < 0 || x >= int(w) || y >= int(h) || d < 1 || d > 4 {
+		return color.NRGBA{}
+	}
+	stride := int(ld)
+	if stride == 0 {
+		stride = int(w * d)
+	}
+	offset := y*stride + x*int(d)
+	p := unsafe.Slice((*uint8)(unsafe.Add(unsafe.Pointer(pix), offset)), int(d))
+	r, g, b, a := pixelAt(p, int(d))
+	return color.NRGBA{R: r, G: g, B: b, A: a}
+}
+
 type SvgImage struct {
 	RgbImage
 }
@@ -272,10 +602,478 @@ func NewBmpImageFromData(data []uint8) (*BmpImage, error) {
 	return img, nil
 }
 
+type GifImage struct {
+	image
+}
+
+// Copy returns a new image resized to w x h. The copy is independent of i.
+func (i *GifImage) Copy(w, h int) *GifImage {
+	img := &GifImage{}
+	if !i.copyTo(img, w, h) {
+		return nil
+	}
+	return img
+}
+
+// Clone returns an exact, independent copy of the image.
+func (i *GifImage) Clone() *GifImage {
+	return i.Copy(i.DataW(), i.DataH())
+}
+
+func NewGifImageLoad(path string) (*GifImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &GifImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_gif_image_load(fileStr)))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+func NewGifImageFromData(data []uint8) (*GifImage, error) {
+	cData := (*C.uchar)(unsafe.Pointer(&data[0]))
+	img := &GifImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_gif_image_data(cData, C.long(len(data)))))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+type AnimGifFlag int
+
+var (
+	// AnimGifDontStart loads the animation without starting it, see Start.
+	AnimGifDontStart = AnimGifFlag(C.go_Fl_Anim_GIF_Image_DONT_START)
+	// AnimGifDontResizeCanvas keeps the canvas widget at its current size.
+	AnimGifDontResizeCanvas = AnimGifFlag(C.go_Fl_Anim_GIF_Image_DONT_RESIZE_CANVAS)
+	// AnimGifDontSetAsImage does not make the animation the canvas' image.
+	AnimGifDontSetAsImage = AnimGifFlag(C.go_Fl_Anim_GIF_Image_DONT_SET_AS_IMAGE)
+	// AnimGifOptimizeMemory stores frames at their own size instead of the canvas size.
+	AnimGifOptimizeMemory = AnimGifFlag(C.go_Fl_Anim_GIF_Image_OPTIMIZE_MEMORY)
+)
+
+// AnimGifImage plays an animated GIF on a canvas widget. The canvas is
+// redrawn on every frame change; it may be nil, in which case the animation
+// must be drawn and advanced by hand. The canvas keeps the animation alive
+// until it is given another canvas or the canvas is deleted.
+type AnimGifImage struct {
+	GifImage
+	// canvas is the widget holding the animation, or nil.
+	canvas *widget
+}
+
+func canvasPtr(canvas Widget) *C.Fl_Widget {
+	if canvas == nil {
+		return nil
+	}
+	return canvas.getWidget().ptr()
+}
+
+func NewAnimGifImageLoad(path string, canvas Widget, flags AnimGifFlag) (*AnimGifImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &AnimGifImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_anim_gif_image_load(fileStr, canvasPtr(canvas), C.int(flags))))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	img.holdCanvas(canvas)
+	return img, nil
+}
+
+func NewAnimGifImageFromData(data []uint8, canvas Widget, flags AnimGifFlag) (*AnimGifImage, error) {
+	cData := (*C.uchar)(unsafe.Pointer(&data[0]))
+	img := &AnimGifImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_anim_gif_image_data(cData, C.long(len(data)), canvasPtr(canvas), C.int(flags))))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	img.holdCanvas(canvas)
+	return img, nil
+}
+
+// holdCanvas makes canvas, instead of the previous canvas, keep the
+// animation alive.
+func (i *AnimGifImage) holdCanvas(canvas Widget) {
+	old := i.canvas
+	i.canvas = nil
+	if canvas != nil {
+		i.canvas = canvas.getWidget()
+		i.canvas.setImages(roleAnimation, i.getImage())
+	}
+	if old != nil && old != i.canvas && old.exists() {
+		old.setImages(roleAnimation)
+	}
+}
+
+func (i *AnimGifImage) aPtr() *C.Fl_Anim_GIF_Image {
+	return (*C.Fl_Anim_GIF_Image)(unsafe.Pointer(i.ptr()))
+}
+
+// Start starts the animation. It returns false if there is nothing to animate.
+func (i *AnimGifImage) Start() bool {
+	return C.go_fltk_anim_gif_image_start(i.aPtr()) != 0
+}
+
+// Stop stops the animation at the current frame.
+func (i *AnimGifImage) Stop() bool {
+	return C.go_fltk_anim_gif_image_stop(i.aPtr()) != 0
+}
+
+// Next shows the next frame of a stopped animation.
+func (i *AnimGifImage) Next() bool {
+	return C.go_fltk_anim_gif_image_next(i.aPtr()) != 0
+}
+
+func (i *AnimGifImage) Playing() bool {
+	return C.go_fltk_anim_gif_image_playing(i.aPtr()) != 0
+}
+
+// IsAnimated reports whether the image has more than one frame.
+func (i *AnimGifImage) IsAnimated() bool {
+	return C.go_fltk_anim_gif_image_is_animated(i.aPtr()) != 0
+}
+
+// Speed returns the playback speed factor, 1 being the speed stored in the file.
+func (i *AnimGifImage) Speed() float64 {
+	return float64(C.go_fltk_anim_gif_image_speed(i.aPtr()))
+}
+
+func (i *AnimGifImage) SetSpeed(speed float64) {
+	C.go_fltk_anim_gif_image_set_speed(i.aPtr(), C.double(speed))
+}
+
+func (i *AnimGifImage) Frames() int {
+	return int(C.go_fltk_anim_gif_image_frames(i.aPtr()))
+}
+
+// Frame returns the index of the frame currently shown.
+func (i *AnimGifImage) Frame() int {
+	return int(C.go_fltk_anim_gif_image_frame(i.aPtr()))
+}
+
+func (i *AnimGifImage) SetFrame(frame int) {
+	C.go_fltk_anim_gif_image_set_frame(i.aPtr(), C.int(frame))
+}
+
+// FrameImage returns the image of the given frame. It is owned by the
+// animation, which frees it; destroying it only makes it unusable.
+func (i *AnimGifImage) FrameImage(frame int) *RgbImage {
+	p := C.go_fltk_anim_gif_image_frame_image(i.aPtr(), C.int(frame))
+	if p == nil {
+		return nil
+	}
+	img := &RgbImage{}
+	initUnownedImage(img, unsafe.Pointer(p))
+	return img
+}
+
+// Delay returns how long the given frame is shown, in seconds.
+func (i *AnimGifImage) Delay(frame int) float64 {
+	return float64(C.go_fltk_anim_gif_image_delay(i.aPtr(), C.int(frame)))
+}
+
+func (i *AnimGifImage) SetDelay(frame int, delay float64) {
+	C.go_fltk_anim_gif_image_set_delay(i.aPtr(), C.int(frame), C.double(delay))
+}
+
+func (i *AnimGifImage) SetCanvas(canvas Widget, flags AnimGifFlag) {
+	C.go_fltk_anim_gif_image_set_canvas(i.aPtr(), canvasPtr(canvas), C.int(flags))
+	i.holdCanvas(canvas)
+}
+
+// IcoImage is a Windows icon. An ICO file usually holds several
+// resolutions; the loaders take the index of the one to use, or -1
+// (the default) to pick the one with the highest resolution.
+type IcoImage struct {
+	BmpImage
+}
+
+type IcoDirEntry struct {
+	Width      int
+	Height     int
+	ColorCount int
+	BitCount   int
+}
+
+func icoId(id []int) C.int {
+	if len(id) == 0 {
+		return -1
+	}
+	return C.int(id[0])
+}
+
+func NewIcoImageLoad(path string, id ...int) (*IcoImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &IcoImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_ico_image_load(fileStr, icoId(id))))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+func NewIcoImageFromData(data []uint8, id ...int) (*IcoImage, error) {
+	cData := (*C.uchar)(unsafe.Pointer(&data[0]))
+	img := &IcoImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_ico_image_data(cData, C.long(len(data)), icoId(id))))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+// Entries describes all the resolutions stored in the icon file.
+func (i *IcoImage) Entries() []IcoDirEntry {
+	p := (*C.Fl_ICO_Image)(unsafe.Pointer(i.ptr()))
+	count := int(C.go_fltk_ico_image_idcount(p))
+	entries := make([]IcoDirEntry, 0, count)
+	for idx := 0; idx < count; idx++ {
+		var w, h, colors, bits C.int
+		C.go_fltk_ico_image_icondirentry(p, C.int(idx), &w, &h, &colors, &bits)
+		entries = append(entries, IcoDirEntry{Width: int(w), Height: int(h), ColorCount: int(colors), BitCount: int(bits)})
+	}
+	return entries
+}
+
+type XpmImage struct {
+	image
+}
+
+// Copy returns a new image resized to w x h. The copy is independent of i.
+func (i *XpmImage) Copy(w, h int) *XpmImage {
+	img := &XpmImage{}
+	if !i.copyTo(img, w, h) {
+		return nil
+	}
+	return img
+}
+
+// Clone returns an exact, independent copy of the image.
+func (i *XpmImage) Clone() *XpmImage {
+	return i.Copy(i.DataW(), i.DataH())
+}
+
+func NewXpmImageLoad(path string) (*XpmImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &XpmImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_xpm_image_load(fileStr)))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+// NewXpmImageFromData loads an XPM image from memory. FLTK can only read
+// XPM files, so the data goes through a temporary file.
+func NewXpmImageFromData(data []uint8) (*XpmImage, error) {
+	var img *XpmImage
+	err := withTempImageFile(data, ".xpm", func(path string) (err error) {
+		img, err = NewXpmImageLoad(path)
+		return err
+	})
+	return img, err
+}
+
+type XbmImage struct {
+	image
+}
+
+// Copy returns a new image resized to w x h. The copy is independent of i.
+func (i *XbmImage) Copy(w, h int) *XbmImage {
+	img := &XbmImage{}
+	if !i.copyTo(img, w, h) {
+		return nil
+	}
+	return img
+}
+
+// Clone returns an exact, independent copy of the image.
+func (i *XbmImage) Clone() *XbmImage {
+	return i.Copy(i.DataW(), i.DataH())
+}
+
+func NewXbmImageLoad(path string) (*XbmImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &XbmImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_xbm_image_load(fileStr)))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+// NewXbmImageFromData loads an XBM image from memory through a temporary file.
+func NewXbmImageFromData(data []uint8) (*XbmImage, error) {
+	var img *XbmImage
+	err := withTempImageFile(data, ".xbm", func(path string) (err error) {
+		img, err = NewXbmImageLoad(path)
+		return err
+	})
+	return img, err
+}
+
+type PnmImage struct {
+	RgbImage
+}
+
+func NewPnmImageLoad(path string) (*PnmImage, error) {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	img := &PnmImage{}
+	initImage(img, unsafe.Pointer(C.go_fltk_pnm_image_load(fileStr)))
+	if err := image_error(img.fail()); err != nil {
+		img.Destroy()
+		return nil, err
+	}
+	return img, nil
+}
+
+// NewPnmImageFromData loads a PBM/PGM/PPM image from memory through a temporary file.
+func NewPnmImageFromData(data []uint8) (*PnmImage, error) {
+	var img *PnmImage
+	err := withTempImageFile(data, ".pnm", func(path string) (err error) {
+		img, err = NewPnmImageLoad(path)
+		return err
+	})
+	return img, err
+}
+
+func withTempImageFile(data []uint8, ext string, load func(path string) error) error {
+	f, err := os.CreateTemp("", "fltk2go-*"+ext)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return load(f.Name())
+}
+
+// NewImageFromData decodes an image held in memory. The format is sniffed
+// from the data; name is only used as a hint for formats without a reliable
+// signature (XBM, SVG) and may be empty.
+func NewImageFromData(data []uint8, name string) (Image, error) {
+	if len(data) == 0 {
+		return nil, ErrNoImage
+	}
+	switch sniffImageFormat(data, name) {
+	case "png":
+		return NewPngImageFromData(data)
+	case "jpeg":
+		return NewJpegImageFromData(data)
+	case "gif":
+		return NewGifImageFromData(data)
+	case "bmp":
+		return NewBmpImageFromData(data)
+	case "ico":
+		return NewIcoImageFromData(data)
+	case "pnm":
+		return NewPnmImageFromData(data)
+	case "xpm":
+		return NewXpmImageFromData(data)
+	case "xbm":
+		return NewXbmImageFromData(data)
+	case "svg":
+		return NewSvgImageFromString(string(data))
+	}
+	return nil, ErrImageDecodingFailed
+}
+
+// LoadImageFromReader reads r to the end and decodes the image, see NewImageFromData.
+func LoadImageFromReader(r io.Reader, name string) (Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewImageFromData(data, name)
+}
+
+// LoadImage loads the named image from fsys, e.g. an embed.FS, sniffing its format.
+func LoadImage(fsys fs.FS, name string) (Image, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewImageFromData(data, name)
+}
+
+func sniffImageFormat(data []uint8, name string) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return "png"
+	case bytes.HasPrefix(data, []byte{0xff, 0xd8, 0xff}):
+		return "jpeg"
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return "gif"
+	case bytes.HasPrefix(data, []byte("BM")):
+		return "bmp"
+	case bytes.HasPrefix(data, []byte{0, 0, 1, 0}):
+		return "ico"
+	case len(data) > 2 && data[0] == 'P' && data[1] >= '1' && data[1] <= '6' && isImageSpace(data[2]):
+		return "pnm"
+	case bytes.HasPrefix(data, []byte("/* XPM */")):
+		return "xpm"
+	}
+	head := strings.ToLower(string(data[:min(len(data), 512)]))
+	if strings.Contains(head, "<svg") {
+		return "svg"
+	}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".xbm":
+		return "xbm"
+	case ".xpm":
+		return "xpm"
+	case ".svg":
+		return "svg"
+	}
+	if strings.HasPrefix(head, "#define") && strings.Contains(head, "_width") {
+		return "xbm"
+	}
+	return ""
+}
+
+func isImageSpace(c uint8) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
 type SharedImage struct {
 	image
 }
 
+// Copy returns a new shared image resized to w x h, with a reference of
+// its own that Release gives up.
+func (i *SharedImage) Copy(w, h int) *SharedImage {
+	img := &SharedImage{}
+	if !i.copyTo(img, w, h) {
+		return nil
+	}
+	return img
+}
+
+// Clone returns an exact, independent copy of the image.
+func (i *SharedImage) Clone() *SharedImage {
+	return i.Copy(i.DataW(), i.DataH())
+}
+
 var shared_init bool
 
 func register_images() {
@@ -300,3 +1098,15 @@ func NewSharedImageLoad(path string) (*SharedImage, error) {
 	}
 	return img, nil
 }
+
+// RefCount returns how many times the shared image was requested and not yet released.
+func (i *SharedImage) RefCount() int {
+	return int(C.go_fltk_shared_image_refcount((*C.Fl_Shared_Image)(unsafe.Pointer(i.ptr()))))
+}
+
+// Release gives up this reference to the shared image. The image is only
+// freed once every holder has released it, so other users of the same
+// file keep working. i must not be used afterwards.
+func (i *SharedImage) Release() {
+	i.Destroy()
+}
@@ -14,6 +14,13 @@ extern "C" {
     typedef struct Fl_BMP_Image Fl_BMP_Image;
     typedef struct Fl_Shared_Image Fl_Shared_Image;
     typedef struct Fl_RGB_Image Fl_RGB_Image;
+    typedef struct Fl_GIF_Image Fl_GIF_Image;
+    typedef struct Fl_Anim_GIF_Image Fl_Anim_GIF_Image;
+    typedef struct Fl_ICO_Image Fl_ICO_Image;
+    typedef struct Fl_XPM_Image Fl_XPM_Image;
+    typedef struct Fl_XBM_Image Fl_XBM_Image;
+    typedef struct Fl_PNM_Image Fl_PNM_Image;
+    typedef struct Fl_Widget Fl_Widget;
 
     extern void go_fltk_image_draw(Fl_Image *, int X, int Y, int W, int H);                                        
     extern void go_fltk_image_draw_ext(Fl_Image *, int X, int Y, int W, int H, int cx, int cy);                    
@@ -30,6 +37,18 @@ extern "C" {
     extern int go_fltk_image_d(const Fl_Image *self);                                                              
     extern int go_fltk_image_ld(const Fl_Image *self);                                                             
     extern void go_fltk_image_inactive(Fl_Image *self);
+    extern Fl_Image *go_fltk_image_copy_sized(Fl_Image *self, int W, int H);
+    extern Fl_Image *go_fltk_image_copy_scaled(Fl_Image *self, int W, int H, int scaling);
+    extern void go_fltk_image_color_average(Fl_Image *self, unsigned int c, float i);
+    extern void go_fltk_image_desaturate(Fl_Image *self);
+    extern void go_fltk_image_set_rgb_scaling(int scaling);
+    extern int go_fltk_image_rgb_scaling(void);
+    extern void go_fltk_image_set_scaling_algorithm(int scaling);
+    extern int go_fltk_image_scaling_algorithm(void);
+    extern int go_fltk_shared_image_refcount(Fl_Shared_Image *self);
+    extern const unsigned char *go_fltk_image_rgb_data(Fl_Image *self, int *w, int *h, int *d, int *ld);
+    extern const unsigned char *go_fltk_image_bitmap_data(Fl_Image *self, int *w, int *h);
+    extern Fl_RGB_Image *go_fltk_image_pixmap_to_rgb(Fl_Image *self);
 
     extern Fl_SVG_Image *go_fltk_svg_image_load(const char *file);
     extern Fl_SVG_Image *go_fltk_svg_image_data(const char *data);
@@ -40,10 +59,45 @@ extern "C" {
     extern Fl_BMP_Image *go_fltk_bmp_image_load(const char *file);
     extern Fl_BMP_Image *go_fltk_bmp_image_data(const unsigned char *data, long size);
     extern Fl_Shared_Image *go_fltk_shared_image_load(const char *file);
+    extern Fl_GIF_Image *go_fltk_gif_image_load(const char *file);
+    extern Fl_GIF_Image *go_fltk_gif_image_data(const unsigned char *data, long size);
+    extern Fl_ICO_Image *go_fltk_ico_image_load(const char *file, int id);
+    extern Fl_ICO_Image *go_fltk_ico_image_data(const unsigned char *data, long size, int id);
+    extern int go_fltk_ico_image_idcount(Fl_ICO_Image *self);
+    extern void go_fltk_ico_image_icondirentry(Fl_ICO_Image *self, int i, int *w, int *h, int *colorCount, int *bitCount);
+    extern Fl_XPM_Image *go_fltk_xpm_image_load(const char *file);
+    extern Fl_XBM_Image *go_fltk_xbm_image_load(const char *file);
+    extern Fl_PNM_Image *go_fltk_pnm_image_load(const char *file);
+
+    extern Fl_Anim_GIF_Image *go_fltk_anim_gif_image_load(const char *file, Fl_Widget *canvas, int flags);
+    extern Fl_Anim_GIF_Image *go_fltk_anim_gif_image_data(const unsigned char *data, long size, Fl_Widget *canvas, int flags);
+    extern int go_fltk_anim_gif_image_start(Fl_Anim_GIF_Image *self);
+    extern int go_fltk_anim_gif_image_stop(Fl_Anim_GIF_Image *self);
+    extern int go_fltk_anim_gif_image_next(Fl_Anim_GIF_Image *self);
+    extern int go_fltk_anim_gif_image_playing(Fl_Anim_GIF_Image *self);
+    extern int go_fltk_anim_gif_image_is_animated(Fl_Anim_GIF_Image *self);
+    extern double go_fltk_anim_gif_image_speed(Fl_Anim_GIF_Image *self);
+    extern void go_fltk_anim_gif_image_set_speed(Fl_Anim_GIF_Image *self, double speed);
+    extern int go_fltk_anim_gif_image_frames(Fl_Anim_GIF_Image *self);
+    extern int go_fltk_anim_gif_image_frame(Fl_Anim_GIF_Image *self);
+    extern void go_fltk_anim_gif_image_set_frame(Fl_Anim_GIF_Image *self, int frame);
+    extern Fl_Image *go_fltk_anim_gif_image_frame_image(Fl_Anim_GIF_Image *self, int frame);
+    extern double go_fltk_anim_gif_image_delay(Fl_Anim_GIF_Image *self, int frame);
+    extern void go_fltk_anim_gif_image_set_delay(Fl_Anim_GIF_Image *self, int frame, double delay);
+    extern void go_fltk_anim_gif_image_set_canvas(Fl_Anim_GIF_Image *self, Fl_Widget *canvas, int flags);
+
+    extern const int go_Fl_Anim_GIF_Image_DONT_START;
+    extern const int go_Fl_Anim_GIF_Image_DONT_RESIZE_CANVAS;
+    extern const int go_Fl_Anim_GIF_Image_DONT_SET_AS_IMAGE;
+    extern const int go_Fl_Anim_GIF_Image_OPTIMIZE_MEMORY;
+
     extern Fl_RGB_Image *go_fltk_rgb_image_data(const unsigned char *bits, int bitsLen, int W, int H, int depth, int ld);
 
     extern void go_fltk_register_images(void);
 
+    extern const int go_FL_RGB_SCALING_NEAREST;
+    extern const int go_FL_RGB_SCALING_BILINEAR;
+
     extern const int go_Fl_Image_ERR_NO_IMAGE;
     extern const int go_Fl_Image_ERR_FILE_ACCESS;
     extern const int go_Fl_Image_ERR_FORMAT;
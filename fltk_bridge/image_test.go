@@ -0,0 +1,95 @@
+package fltk_bridge
+
+import (
+	"bytes"
+	goimage "image"
+	"image/color"
+	"image/gif"
+	"testing"
+)
+
+// twoFrameGIF returns an animated GIF of two 4x4 frames.
+func twoFrameGIF(t *testing.T) []byte {
+	palette := color.Palette{color.Black, color.White}
+	anim := &gif.GIF{}
+	for frame := 0; frame < 2; frame++ {
+		img := goimage.NewPaletted(goimage.Rect(0, 0, 4, 4), palette)
+		img.SetColorIndex(frame, frame, 1)
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, 10)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestAnimGifFrameImageDestroy(t *testing.T) {
+	live := LiveImageCount()
+	anim, err := NewAnimGifImageFromData(twoFrameGIF(t), nil, AnimGifDontStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anim.Frames() != 2 {
+		t.Fatalf("Frames() = %d, want 2", anim.Frames())
+	}
+
+	frame := anim.FrameImage(0)
+	if frame == nil {
+		t.Fatal("FrameImage(0) = nil")
+	}
+	frame.Destroy()
+	if frame.iPtr != nil {
+		t.Error("destroyed frame image still has its pointer")
+	}
+	if n := LiveImageCount(); n != live+1 {
+		t.Errorf("LiveImageCount() = %d after destroying a frame, want %d", n, live+1)
+	}
+
+	// The animation still owns the frame, which destroying it left alone.
+	if again := anim.FrameImage(0); again == nil || again.W() != 4 || again.H() != 4 {
+		t.Error("frame is gone from the animation after destroying its image")
+	}
+
+	anim.Destroy()
+	if n := LiveImageCount(); n != live {
+		t.Errorf("LiveImageCount() = %d after destroying the animation, want %d", n, live)
+	}
+}
+
+func TestImageCopy(t *testing.T) {
+	live := LiveImageCount()
+	gifImg, err := NewGifImageFromData(twoFrameGIF(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	xbmImg, err := NewXbmImageFromData([]byte("#define t_width 8\n#define t_height 2\nstatic unsigned char t_bits[] = { 0x0f, 0xf0 };\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gifCopy := gifImg.Copy(8, 6)
+	if gifCopy == nil || gifCopy.W() != 8 || gifCopy.H() != 6 {
+		t.Fatalf("GifImage.Copy(8, 6) = %v", gifCopy)
+	}
+	xbmCopy := xbmImg.Clone()
+	if xbmCopy == nil || xbmCopy.W() != 8 || xbmCopy.H() != 2 {
+		t.Fatalf("XbmImage.Clone() = %v", xbmCopy)
+	}
+	if n := LiveImageCount(); n != live+4 {
+		t.Errorf("LiveImageCount() = %d with two copies, want %d", n, live+4)
+	}
+
+	// The copies are independent of their originals.
+	gifImg.Destroy()
+	xbmImg.Destroy()
+	if gifCopy.W() != 8 || xbmCopy.H() != 2 {
+		t.Error("copies changed when their originals were destroyed")
+	}
+	gifCopy.Destroy()
+	xbmCopy.Destroy()
+	if n := LiveImageCount(); n != live {
+		t.Errorf("LiveImageCount() = %d after destroying all, want %d", n, live)
+	}
+}
@@ -0,0 +1,335 @@
+package fltk_bridge
+
+/*
+#include "text.h"
+*/
+import "C"
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+const defaultLogViewMaxLines = 10000
+
+// logLevels are the style buckets of a LogView: debug, info, warn and error.
+const logLevels = 4
+
+func logLevelIndex(level slog.Level) int {
+	switch {
+	case level < slog.LevelInfo:
+		return 0
+	case level < slog.LevelWarn:
+		return 1
+	case level < slog.LevelError:
+		return 2
+	}
+	return 3
+}
+
+type logLine struct {
+	level slog.Level
+	text  string
+}
+
+// LogView is a read-only TextDisplay for streaming log output. It keeps at
+// most MaxLines lines, dropping the oldest ones, colors lines by level and
+// can show only the lines that match a filter. While following, the view
+// stays scrolled to the newest line; scrolling up stops that and scrolling
+// back to the bottom resumes it. The view owns its buffers, which are freed
+// when it is deleted.
+type LogView struct {
+	TextDisplay
+	text, style *TextBuffer
+	// lines[first:] are the kept lines, oldest first.
+	lines    []logLine
+	first    int
+	maxLines int
+	// sink passes lines from Write and Handler to the UI thread.
+	sink        *logSink
+	styles      [logLevels]StyleTableEntry
+	filterText  string
+	filterLevel slog.Level
+	follow      bool
+	// checkFollow asks the next draw to update follow from the scroll
+	// position, after an event that may have scrolled the view.
+	checkFollow bool
+}
+
+func NewLogView(x, y, w, h int) *LogView {
+	l := &LogView{
+		text:        NewTextBuffer(),
+		style:       NewTextBuffer(),
+		maxLines:    defaultLogViewMaxLines,
+		filterLevel: slog.LevelDebug - 4,
+		follow:      true,
+	}
+	l.sink = &logSink{view: l}
+	initWidget(l, unsafe.Pointer(C.go_fltk_new_TextDisplay(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+	l.SetBuffer(l.text)
+	l.text.DestroyWith(l)
+	l.style.DestroyWith(l)
+	l.SetTextFont(COURIER)
+	size := l.TextSize()
+	l.styles = [logLevels]StyleTableEntry{
+		{Color: ColorFromRgb(0x80, 0x80, 0x80), Font: COURIER, Size: size},
+		{Color: FOREGROUND_COLOR, Font: COURIER, Size: size},
+		{Color: ColorFromRgb(0xb0, 0x70, 0x00), Font: COURIER, Size: size},
+		{Color: ColorFromRgb(0xc0, 0x00, 0x00), Font: COURIER_BOLD, Size: size},
+	}
+	l.SetHighlightData(l.style, l.styles[:])
+	l.SetEventHandler(func(e Event) bool {
+		switch e {
+		case MOUSEWHEEL:
+			if EventDY() < 0 {
+				l.follow = false
+			} else {
+				l.checkFollow = true
+			}
+		case PUSH, DRAG, RELEASE, KEYDOWN:
+			l.checkFollow = true
+		}
+		return false
+	})
+	l.SetDrawHandler(func(base func()) {
+		base()
+		if l.checkFollow {
+			l.checkFollow = false
+			l.follow = l.atEnd()
+		}
+	})
+	return l
+}
+
+// SetLevelStyle sets how lines of level and the levels up to the next of
+// Debug, Info, Warn and Error are drawn.
+func (l *LogView) SetLevelStyle(level slog.Level, style StyleTableEntry) {
+	l.styles[logLevelIndex(level)] = style
+	l.SetHighlightData(l.style, l.styles[:])
+	l.Redraw()
+}
+
+func (l *LogView) MaxLines() int { return l.maxLines }
+
+// SetMaxLines sets how many lines are kept, 0 for no limit, and drops the
+// oldest lines if there are more.
+func (l *LogView) SetMaxLines(n int) {
+	l.maxLines = max(n, 0)
+	l.trim()
+}
+
+// LineCount returns the number of kept lines, including filtered out ones.
+func (l *LogView) LineCount() int { return len(l.lines) - l.first }
+
+// Append adds text at level, one line per line of text.
+func (l *LogView) Append(level slog.Level, text string) {
+	text = strings.TrimSuffix(text, "\n")
+	var shown, style strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		entry := logLine{level, line}
+		l.lines = append(l.lines, entry)
+		if l.matches(entry) {
+			shown.WriteString(line)
+			shown.WriteByte('\n')
+			style.WriteString(strings.Repeat(l.styleChar(level), len(line)+1))
+		}
+	}
+	if shown.Len() > 0 {
+		l.style.Append(style.String())
+		l.text.Append(shown.String())
+	}
+	l.trim()
+	if l.follow {
+		l.scrollToEnd()
+	}
+}
+
+// Write appends p at info level, so that the view can be the output of a
+// log.Logger or a command. A last line without a line break is held back
+// until the rest of it is written. Like Handler, it may be used from any
+// goroutine once the program has called Lock: the lines are handed to the
+// view through Awake.
+func (l *LogView) Write(p []byte) (int, error) {
+	s := l.sink
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	text := s.partial + string(p)
+	end := strings.LastIndexByte(text, '\n')
+	s.partial = text[end+1:]
+	if end >= 0 {
+		s.queue(logLine{slog.LevelInfo, text[:end]})
+	}
+	return len(p), nil
+}
+
+// Clear removes all lines.
+func (l *LogView) Clear() {
+	l.lines, l.first = nil, 0
+	l.sink.mu.Lock()
+	l.sink.partial = ""
+	l.sink.mu.Unlock()
+	l.text.SetText("")
+	l.style.SetText("")
+}
+
+func (l *LogView) Follow() bool { return l.follow }
+
+// SetFollow turns following the newest line on or off.
+func (l *LogView) SetFollow(follow bool) {
+	l.follow = follow
+	if follow {
+		l.scrollToEnd()
+	}
+}
+
+func (l *LogView) FilterText() string      { return l.filterText }
+func (l *LogView) FilterLevel() slog.Level { return l.filterLevel }
+
+// SetFilter shows only the lines that contain text and have at least level.
+// An empty text matches every line.
+func (l *LogView) SetFilter(text string, level slog.Level) {
+	l.filterText, l.filterLevel = text, level
+	var shown, style strings.Builder
+	for _, entry := range l.lines[l.first:] {
+		if l.matches(entry) {
+			shown.WriteString(entry.text)
+			shown.WriteByte('\n')
+			style.WriteString(strings.Repeat(l.styleChar(entry.level), len(entry.text)+1))
+		}
+	}
+	l.style.SetText(style.String())
+	l.text.SetText(shown.String())
+	if l.follow {
+		l.scrollToEnd()
+	}
+}
+
+// ClearFilter shows all lines again.
+func (l *LogView) ClearFilter() {
+	l.SetFilter("", slog.LevelDebug-4)
+}
+
+func (l *LogView) matches(entry logLine) bool {
+	return entry.level >= l.filterLevel && strings.Contains(entry.text, l.filterText)
+}
+
+func (l *LogView) styleChar(level slog.Level) string {
+	return string(rune('A' + logLevelIndex(level)))
+}
+
+// trim drops the oldest lines beyond maxLines, from the display too.
+func (l *LogView) trim() {
+	excess := l.LineCount() - l.maxLines
+	if l.maxLines == 0 || excess <= 0 {
+		return
+	}
+	shown := 0
+	for _, entry := range l.lines[l.first : l.first+excess] {
+		if l.matches(entry) {
+			shown++
+		}
+	}
+	if shown > 0 {
+		end := l.text.SkipLines(0, shown)
+		l.text.Remove(0, end)
+		l.style.Remove(0, end)
+	}
+	clear(l.lines[l.first : l.first+excess])
+	l.first += excess
+	if l.first >= len(l.lines)/2 {
+		l.lines = append(l.lines[:0], l.lines[l.first:]...)
+		l.first = 0
+	}
+}
+
+func (l *LogView) scrollToEnd() {
+	if n := l.text.Length(); n > 0 {
+		l.SetInsertPosition(l.text.LineStart(n - 1))
+		l.ShowInsertPosition()
+	}
+}
+
+// atEnd reports whether the newest line is on screen.
+func (l *LogView) atEnd() bool {
+	n := l.text.Length()
+	return n == 0 || l.PositionVisible(l.text.LineStart(n-1))
+}
+
+// Handler returns a slog.Handler that writes records to the view in the
+// text format of slog.TextHandler, colored by level. It may be used from any
+// goroutine once the program has called Lock: records are handed to the
+// view through Awake.
+func (l *LogView) Handler(opts *slog.HandlerOptions) slog.Handler {
+	return &logViewHandler{inner: slog.NewTextHandler(l.sink, opts), sink: l.sink}
+}
+
+// logSink collects the lines written to the view and the output of a
+// slog.TextHandler and passes them on to the view on the UI thread.
+type logSink struct {
+	view *LogView
+	mu   sync.Mutex
+	// buf holds the record being formatted.
+	buf bytes.Buffer
+	// partial is a line written without its line break yet.
+	partial string
+	pending []logLine
+	awake   bool
+}
+
+func (s *logSink) Write(p []byte) (int, error) {
+	return s.buf.Write(p)
+}
+
+// queue hands line to the view, s.mu being held.
+func (s *logSink) queue(line logLine) {
+	s.pending = append(s.pending, line)
+	if !s.awake {
+		s.awake = true
+		Awake(s.flush)
+	}
+}
+
+func (s *logSink) flush() {
+	s.mu.Lock()
+	lines := s.pending
+	s.pending, s.awake = nil, false
+	s.mu.Unlock()
+	if !s.view.exists() {
+		return
+	}
+	for _, line := range lines {
+		s.view.Append(line.level, line.text)
+	}
+}
+
+type logViewHandler struct {
+	inner slog.Handler
+	sink  *logSink
+}
+
+func (h *logViewHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *logViewHandler) Handle(ctx context.Context, r slog.Record) error {
+	s := h.sink
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buf.Reset()
+	if err := h.inner.Handle(ctx, r); err != nil {
+		return err
+	}
+	s.queue(logLine{r.Level, s.buf.String()})
+	return nil
+}
+
+func (h *logViewHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logViewHandler{inner: h.inner.WithAttrs(attrs), sink: h.sink}
+}
+
+func (h *logViewHandler) WithGroup(name string) slog.Handler {
+	return &logViewHandler{inner: h.inner.WithGroup(name), sink: h.sink}
+}
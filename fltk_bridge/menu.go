@@ -66,7 +66,9 @@ func (m *menu) AddExWithIcon(label string, shortcut int, callback func(), flags
 	m.itemCallbacks = append(m.itemCallbacks, callbackId)
 	labelStr := C.CString(label)
 	defer C.free(unsafe.Pointer(labelStr))
-	return int(C.go_fltk_Menu_add_with_icon((*C.Fl_Menu_)(m.ptr()), labelStr, C.int(shortcut), C.int(callbackId), C.int(flags), img.getImage().ptr()))
+	idx := int(C.go_fltk_Menu_add_with_icon((*C.Fl_Menu_)(m.ptr()), labelStr, C.int(shortcut), C.int(callbackId), C.int(flags), img.getImage().ptr()))
+	m.addImage(roleIcons, img.getImage())
+	return idx
 }
 func (m *menu) Insert(index int, label string, callback func()) int {
 	callbackId := globalCallbackMap.register(callback)
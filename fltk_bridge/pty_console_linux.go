@@ -0,0 +1,346 @@
+package fltk_bridge
+
+/*
+#include "terminal.h"
+*/
+import "C"
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// ptyDrainTimeout bounds how long the exit of a program waits for the rest
+// of its output, which never ends while a process it left behind keeps the
+// pty open.
+const ptyDrainTimeout = time.Second
+
+var ErrPtyConsoleBusy = errors.New("a program is already running in the console")
+
+// PtyConsole is a Terminal that runs a program, such as a shell, ssh or a
+// REPL, on a pseudo terminal. The program's output is shown as it arrives,
+// keys typed into the console are sent to it, including control keys,
+// and the program is told the size of the console whenever it changes.
+// Ctrl+Shift+C copies the selection and Ctrl+Shift+V pastes.
+//
+// Output is handed to the console through Awake, so the program must have
+// called Lock. The console uses its own event and resize handlers; replacing
+// them stops input and size changes from reaching the program.
+type PtyConsole struct {
+	Terminal
+	cmd     *exec.Cmd
+	pty     *os.File
+	state   *os.ProcessState
+	onExit  func(state *os.ProcessState, err error)
+	running bool
+}
+
+func NewPtyConsole(x, y, w, h int) *PtyConsole {
+	c := &PtyConsole{}
+	initWidget(c, unsafe.Pointer(C.go_fltk_new_Terminal(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+	// The line discipline of the pty already turns line feeds into CR LF.
+	c.SetOutputTranslate(TerminalOutputOff)
+	c.SetEventHandler(c.onEvent)
+	c.SetResizeHandler(c.updateSize)
+	// Deleting the console, also along with its window, hangs up on the
+	// program like closing a terminal does.
+	c.addDeletionHandler(func() { c.HangUp() })
+	return c
+}
+
+// SetExitHandler sets the function called on the UI thread when the program
+// ends, after all of its output has been shown. state is nil if waiting for
+// the program failed, in which case err says why; a non-zero exit status is
+// not an error.
+func (c *PtyConsole) SetExitHandler(handler func(state *os.ProcessState, err error)) {
+	c.onExit = handler
+}
+
+// Start runs cmd on a new pty with the console as its controlling terminal.
+// cmd's standard input, output and error are connected to the pty and TERM
+// is set to xterm unless cmd.Env already sets it.
+func (c *PtyConsole) Start(cmd *exec.Cmd) error {
+	if c.running {
+		return ErrPtyConsoleBusy
+	}
+	master, slave, err := openPty()
+	if err != nil {
+		return err
+	}
+	defer slave.Close()
+	c.pty = master
+	c.updateSize()
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	if !hasEnv(cmd.Env, "TERM") {
+		cmd.Env = append(cmd.Env, "TERM=xterm")
+	}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = slave, slave, slave
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0
+	if err := cmd.Start(); err != nil {
+		master.Close()
+		c.pty = nil
+		return err
+	}
+	c.cmd, c.state, c.running = cmd, nil, true
+
+	drained := make(chan struct{})
+	go c.read(master, drained)
+	go func() {
+		err := cmd.Wait()
+		select {
+		case <-drained:
+		case <-time.After(ptyDrainTimeout):
+		}
+		Awake(func() { c.exited(master, cmd.ProcessState, err) })
+	}()
+	return nil
+}
+
+// Running reports whether a program started in the console has not ended
+// yet.
+func (c *PtyConsole) Running() bool { return c.running }
+
+// ProcessState returns the state of the last program that ended, or nil.
+func (c *PtyConsole) ProcessState() *os.ProcessState { return c.state }
+
+// ExitCode returns the exit code of the last program that ended, or -1 if
+// none has or it was killed by a signal.
+func (c *PtyConsole) ExitCode() int {
+	if c.state == nil {
+		return -1
+	}
+	return c.state.ExitCode()
+}
+
+// Send writes text to the program as if it had been typed.
+func (c *PtyConsole) Send(text string) error {
+	if !c.running {
+		return os.ErrClosed
+	}
+	_, err := c.pty.Write([]byte(text))
+	return err
+}
+
+// HangUp sends SIGHUP to the program and the processes it started, as when
+// a terminal window is closed. The exit handler is called once it ends.
+func (c *PtyConsole) HangUp() error {
+	if !c.running {
+		return nil
+	}
+	return syscall.Kill(-c.cmd.Process.Pid, syscall.SIGHUP)
+}
+
+// read passes the output of the program to the console until the pty is
+// closed, which Linux reports as EIO once the program has ended.
+func (c *PtyConsole) read(master *os.File, drained chan<- struct{}) {
+	defer close(drained)
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := master.Read(buf)
+		if n > 0 {
+			c.Terminal.Write(buf[:n])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (c *PtyConsole) exited(master *os.File, state *os.ProcessState, err error) {
+	c.flush()
+	master.Close()
+	if c.pty == master {
+		c.pty, c.cmd, c.running = nil, nil, false
+		c.state = state
+	}
+	if state != nil {
+		err = nil
+	}
+	if c.onExit != nil && c.exists() {
+		c.onExit(state, err)
+	}
+}
+
+func (c *PtyConsole) onEvent(e Event) bool {
+	switch e {
+	case FOCUS, UNFOCUS:
+		return true
+	case PUSH:
+		c.TakeFocus()
+	case KEYDOWN:
+		state, key := EventState(), EventKey()
+		if state&CTRL != 0 && state&SHIFT != 0 {
+			switch key {
+			case 'c':
+				c.CopySelection()
+				return true
+			case 'v':
+				c.Paste(true)
+				return true
+			}
+		}
+		if !c.running {
+			return false
+		}
+		input := ptyKeyInput(key, state, EventText())
+		if input == "" {
+			return false
+		}
+		c.ClearSelection()
+		c.Send(input)
+		return true
+	case PASTE:
+		if c.running {
+			c.Send(EventText())
+			return true
+		}
+	}
+	return false
+}
+
+// ptyKeyInput returns what an xterm sends for a key press: an escape
+// sequence for editing, cursor and function keys, a control character for
+// Ctrl with a letter or one of @[\]^_/ and otherwise the typed text, led by
+// ESC while Alt is held.
+func ptyKeyInput(key, state int, text string) string {
+	switch key {
+	case ESCAPE:
+		return "\x1b"
+	case TAB:
+		if state&SHIFT != 0 {
+			return "\x1b[Z"
+		}
+		return "\t"
+	case ENTER_KEY:
+		return "\r"
+	case BACKSPACE:
+		return "\x7f"
+	case UP:
+		return "\x1b[A"
+	case DOWN:
+		return "\x1b[B"
+	case RIGHT:
+		return "\x1b[C"
+	case LEFT:
+		return "\x1b[D"
+	case HOME:
+		return "\x1b[H"
+	case END:
+		return "\x1b[F"
+	case INSERT:
+		return "\x1b[2~"
+	case DELETE:
+		return "\x1b[3~"
+	case PAGE_UP:
+		return "\x1b[5~"
+	case PAGE_DOWN:
+		return "\x1b[6~"
+	}
+	if key >= F1 && key <= F12 {
+		n := key - F1
+		if n < 4 {
+			return "\x1bO" + string(rune('P'+n))
+		}
+		return "\x1b[" + strconv.Itoa([]int{15, 17, 18, 19, 20, 21, 23, 24}[n-4]) + "~"
+	}
+	if state&CTRL != 0 {
+		switch {
+		case key >= 'a' && key <= 'z':
+			text = string(rune(key - 'a' + 1))
+		case key == ' ' || key == '@' || key == '2':
+			text = "\x00"
+		case key >= '[' && key <= '_':
+			text = string(rune(key - '@'))
+		case key == '/':
+			text = "\x1f"
+		}
+	}
+	if text != "" && state&ALT != 0 {
+		text = "\x1b" + text
+	}
+	return text
+}
+
+// updateSize tells the program how many rows and columns the console has.
+func (c *PtyConsole) updateSize() {
+	if c.pty == nil {
+		return
+	}
+	size := ptyWinsize{
+		rows:   uint16(c.DisplayRows()),
+		cols:   uint16(c.DisplayColumns()),
+		xpixel: uint16(c.W()),
+		ypixel: uint16(c.H()),
+	}
+	ptyIoctl(c.pty, syscall.TIOCSWINSZ, unsafe.Pointer(&size))
+}
+
+func hasEnv(env []string, name string) bool {
+	for _, kv := range env {
+		if strings.HasPrefix(kv, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+type ptyWinsize struct {
+	rows, cols     uint16
+	xpixel, ypixel uint16
+}
+
+// openPty opens a new pty pair through /dev/ptmx.
+func openPty() (master, slave *os.File, err error) {
+	master, err = os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	var unlock int32
+	if err := ptyIoctl(master, syscall.TIOCSPTLCK, unsafe.Pointer(&unlock)); err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	var n uint32
+	if err := ptyIoctl(master, syscall.TIOCGPTN, unsafe.Pointer(&n)); err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	slave, err = os.OpenFile("/dev/pts/"+strconv.Itoa(int(n)), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	return master, slave, nil
+}
+
+// ptyIoctl runs an ioctl on f without taking it out of non-blocking mode,
+// so that closing it still interrupts a pending Read.
+func ptyIoctl(f *os.File, request uintptr, arg unsafe.Pointer) error {
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var errno syscall.Errno
+	if err := conn.Control(func(fd uintptr) {
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(arg))
+	}); err != nil {
+		return err
+	}
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
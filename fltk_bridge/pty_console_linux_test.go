@@ -0,0 +1,57 @@
+package fltk_bridge
+
+import "testing"
+
+func TestPtyKeyInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   int
+		state int
+		text  string
+		want  string
+	}{
+		{"escape", ESCAPE, 0, "\x1b", "\x1b"},
+		{"tab", TAB, 0, "\t", "\t"},
+		{"shift tab", TAB, SHIFT, "\t", "\x1b[Z"},
+		{"enter", ENTER_KEY, 0, "\r", "\r"},
+		{"backspace", BACKSPACE, 0, "\b", "\x7f"},
+		{"up", UP, 0, "", "\x1b[A"},
+		{"left with shift", LEFT, SHIFT, "", "\x1b[D"},
+		{"home", HOME, 0, "", "\x1b[H"},
+		{"end", END, 0, "", "\x1b[F"},
+		{"insert", INSERT, 0, "", "\x1b[2~"},
+		{"delete", DELETE, 0, "", "\x1b[3~"},
+		{"page up", PAGE_UP, 0, "", "\x1b[5~"},
+		{"page down", PAGE_DOWN, 0, "", "\x1b[6~"},
+		{"F1", F1, 0, "", "\x1bOP"},
+		{"F4", F4, 0, "", "\x1bOS"},
+		{"F5", F5, 0, "", "\x1b[15~"},
+		{"F6 skips 16", F6, 0, "", "\x1b[17~"},
+		{"F10", F10, 0, "", "\x1b[21~"},
+		{"F11 skips 22", F11, 0, "", "\x1b[23~"},
+		{"F12", F12, 0, "", "\x1b[24~"},
+		{"letter", 'a', 0, "a", "a"},
+		{"shifted letter", 'a', SHIFT, "A", "A"},
+		{"ctrl a", 'a', CTRL, "", "\x01"},
+		{"ctrl z", 'z', CTRL, "", "\x1a"},
+		{"ctrl space", ' ', CTRL, " ", "\x00"},
+		{"ctrl @", '@', CTRL, "", "\x00"},
+		{"ctrl 2", '2', CTRL, "", "\x00"},
+		{"ctrl [", '[', CTRL, "", "\x1b"},
+		{"ctrl backslash", '\\', CTRL, "", "\x1c"},
+		{"ctrl ]", ']', CTRL, "", "\x1d"},
+		{"ctrl ^", '^', CTRL, "", "\x1e"},
+		{"ctrl _", '_', CTRL, "", "\x1f"},
+		{"ctrl /", '/', CTRL, "", "\x1f"},
+		{"ctrl digit keeps text", '5', CTRL, "5", "5"},
+		{"alt letter", 'x', ALT, "x", "\x1bx"},
+		{"ctrl alt letter", 'c', CTRL | ALT, "", "\x1b\x03"},
+		{"alt without text", 'x', ALT, "", ""},
+		{"unicode text", 'e', 0, "é", "é"},
+	}
+	for _, tt := range tests {
+		if got := ptyKeyInput(tt.key, tt.state, tt.text); got != tt.want {
+			t.Errorf("%s: ptyKeyInput(%d, %#x, %q) = %q, want %q", tt.name, tt.key, tt.state, tt.text, got, tt.want)
+		}
+	}
+}
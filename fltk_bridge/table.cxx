@@ -7,15 +7,18 @@
 #include "_cgo_export.h"
 
 
-class GTableRow : public EventHandler<Fl_Table_Row> {
+// GTableCells adds the Go draw cell callback and cell lookups to a table
+// class.
+template <class Base>
+class GTableCells : public EventHandler<Base> {
 public:
-  GTableRow(int x, int y, int w, int h)
-    : EventHandler<Fl_Table_Row>(x, y, w, h) {}
-  
+  GTableCells(int x, int y, int w, int h)
+    : EventHandler<Base>(x, y, w, h) {}
+
   void set_draw_cell_callback(int drawFunId) {
     m_drawFunId = drawFunId;
   }
-  void draw_cell(TableContext context, int R, int C, int X, int Y, int W, int H) final {
+  void draw_cell(Fl_Table::TableContext context, int R, int C, int X, int Y, int W, int H) final {
     if (m_drawFunId > 0) {
       _go_drawTableHandler(m_drawFunId, (int)context, R, C, X, Y, W, H);
     }
@@ -27,15 +30,33 @@ public:
     *x = X, *y = Y, *w = W, *h = H;
     return ret;
   }
-	
+
+  int cell_from_cursor(int *r, int *c) {
+    int row = 0;
+    int col = 0;
+    Fl_Table::ResizeFlag rflag = Fl_Table::RESIZE_NONE;
+    Fl_Table::TableContext ctx = this->cursor2rowcol(row, col, rflag);
+    *r = row, *c = col;
+    return (int)ctx;
+  }
+
+  // on_resize_border reports whether the mouse is on a row or column border
+  // that dragging resizes.
+  int on_resize_border() {
+    int row = 0;
+    int col = 0;
+    Fl_Table::ResizeFlag rflag = Fl_Table::RESIZE_NONE;
+    this->cursor2rowcol(row, col, rflag);
+    return rflag != Fl_Table::RESIZE_NONE;
+  }
+
   int row_from_cursor() {
 	int row = 0;
 	int col = 0;
-	ResizeFlag rflag = RESIZE_NONE;
-	TableContext ctx = cursor2rowcol(row, col, rflag);
-	if (ctx == CONTEXT_COL_HEADER)
+	Fl_Table::TableContext ctx = (Fl_Table::TableContext)cell_from_cursor(&row, &col);
+	if (ctx == Fl_Table::CONTEXT_COL_HEADER)
 		row = -1;
-	else if (ctx != CONTEXT_CELL && ctx != CONTEXT_ROW_HEADER)
+	else if (ctx != Fl_Table::CONTEXT_CELL && ctx != Fl_Table::CONTEXT_ROW_HEADER)
 		row = -2;
 	return row;
   }
@@ -43,19 +64,41 @@ public:
   int column_from_cursor() {
 	int row = 0;
 	int col = 0;
-	ResizeFlag rflag = RESIZE_NONE;
-	TableContext ctx = cursor2rowcol(row, col, rflag);
-	if (ctx == CONTEXT_ROW_HEADER)
+	Fl_Table::TableContext ctx = (Fl_Table::TableContext)cell_from_cursor(&row, &col);
+	if (ctx == Fl_Table::CONTEXT_ROW_HEADER)
 		col = -1;
-	else if (ctx != CONTEXT_CELL && ctx != CONTEXT_COL_HEADER)
+	else if (ctx != Fl_Table::CONTEXT_CELL && ctx != Fl_Table::CONTEXT_COL_HEADER)
 		col = -2;
 	return col;
   }
-	
+
+  void redraw_cells(int top, int bottom, int left, int right) {
+    this->redraw_range(top, bottom, left, right);
+  }
+
+  // cursor returns the cell that keyboard navigation moves, which is the
+  // moving end of the selection. Fl_Table_Row hides select_row with a
+  // method of the same name.
+  void cursor(int *r, int *c) {
+    *r = this->Fl_Table::select_row, *c = this->Fl_Table::select_col;
+  }
+
 private:
   int m_drawFunId = 0;
 };
 
+class GTableRow : public GTableCells<Fl_Table_Row> {
+public:
+  GTableRow(int x, int y, int w, int h)
+    : GTableCells<Fl_Table_Row>(x, y, w, h) {}
+};
+
+class GTable : public GTableCells<Fl_Table> {
+public:
+  GTable(int x, int y, int w, int h)
+    : GTableCells<Fl_Table>(x, y, w, h) {}
+};
+
 GTableRow *go_fltk_new_TableRow(int x, int y, int w, int h) {
   return new GTableRow(x, y, w, h);
 }
@@ -77,6 +120,15 @@ void go_fltk_TableRow_select_row(GTableRow* t, int row, int flag) {
 int go_fltk_TableRow_find_cell(GTableRow* t, int ctx, int r, int c, int *x, int *y, int *w, int *h) {
   return t->find_cell_(ctx, r, c, x, y, w, h);
 }
+int go_fltk_TableRow_cell_from_cursor(GTableRow* t, int *row, int *col) {
+  return t->cell_from_cursor(row, col);
+}
+void go_fltk_TableRow_cursor(GTableRow* t, int *row, int *col) {
+  t->cursor(row, col);
+}
+int go_fltk_TableRow_on_resize_border(GTableRow* t) {
+  return t->on_resize_border();
+}
 void go_fltk_Table_set_row_count(Fl_Table* t, int rowCount) {
   t->rows(rowCount);
 }
@@ -155,6 +207,58 @@ int go_fltk_Table_column_from_cursor(GTableRow* t) {
 int go_fltk_Table_row_from_cursor(GTableRow* t) {
 	return t->row_from_cursor();
 }
+int go_fltk_Table_column_count(Fl_Table* t) {
+  return t->cols();
+}
+int go_fltk_Table_row_height(Fl_Table* t, int row) {
+  return t->row_height(row);
+}
+int go_fltk_Table_column_width(Fl_Table* t, int column) {
+  return t->col_width(column);
+}
+void go_fltk_Table_set_selection(Fl_Table* t, int top, int left, int bottom, int right) {
+  t->set_selection(top, left, bottom, right);
+}
+void go_fltk_Table_add(Fl_Table* t, Fl_Widget* w) {
+  t->add(w);
+}
+void go_fltk_Table_remove(Fl_Table* t, Fl_Widget* w) {
+  t->remove(*w);
+}
+void go_fltk_TableRow_redraw_range(GTableRow* t, int top, int bottom, int left, int right) {
+  t->redraw_cells(top, bottom, left, right);
+}
+void go_fltk_Table_redraw_range(GTable* t, int top, int bottom, int left, int right) {
+  t->redraw_cells(top, bottom, left, right);
+}
+
+GTable *go_fltk_new_Table(int x, int y, int w, int h) {
+  return new GTable(x, y, w, h);
+}
+void go_fltk_Table_set_draw_cell_callback(GTable* t, int drawFunId) {
+  t->set_draw_cell_callback(drawFunId);
+}
+int go_fltk_Table_find_cell(GTable* t, int ctx, int r, int c, int *x, int *y, int *w, int *h) {
+  return t->find_cell_(ctx, r, c, x, y, w, h);
+}
+int go_fltk_Table_cell_from_cursor(GTable* t, int *row, int *col) {
+  return t->cell_from_cursor(row, col);
+}
+void go_fltk_Table_cursor(GTable* t, int *row, int *col) {
+  t->cursor(row, col);
+}
+int go_fltk_Table_is_selected(GTable* t, int row, int col) {
+  return t->is_selected(row, col);
+}
+int go_fltk_Table_move_cursor(GTable* t, int rowDelta, int colDelta, int shiftSelect) {
+  return t->move_cursor(rowDelta, colDelta, shiftSelect);
+}
+void go_fltk_Table_set_tab_cell_nav(GTable* t, int on) {
+  t->tab_cell_nav(on);
+}
+int go_fltk_Table_tab_cell_nav(GTable* t) {
+  return t->tab_cell_nav();
+}
 
 const int go_FL_CONTEXT_NONE = (int)Fl_Table::CONTEXT_NONE;
 const int go_FL_CONTEXT_STARTPAGE = (int)Fl_Table::CONTEXT_STARTPAGE;
@@ -101,6 +101,31 @@ func (t *table) SetColumnHeaderHeight(size int) {
 func (t *table) ColumnHeaderHeight() int {
 	return int(C.go_fltk_Table_column_header_height((*C.Fl_Table)(t.ptr())))
 }
+func (t *table) ColumnCount() int {
+	return int(C.go_fltk_Table_column_count((*C.Fl_Table)(t.ptr())))
+}
+func (t *table) RowHeight(row int) int {
+	return int(C.go_fltk_Table_row_height((*C.Fl_Table)(t.ptr()), C.int(row)))
+}
+func (t *table) ColumnWidth(column int) int {
+	return int(C.go_fltk_Table_column_width((*C.Fl_Table)(t.ptr()), C.int(column)))
+}
+
+// SetSelection selects the cells from row top, column left to row bottom,
+// column right. Only a Table draws cell selections.
+func (t *table) SetSelection(top, left, bottom, right int) {
+	C.go_fltk_Table_set_selection((*C.Fl_Table)(t.ptr()), C.int(top), C.int(left), C.int(bottom), C.int(right))
+}
+
+// Add adds w to the scrolled area of the table, where widgets placed over
+// cells belong, rather than to the table itself.
+func (t *table) Add(w Widget) {
+	C.go_fltk_Table_add((*C.Fl_Table)(t.ptr()), (*C.Fl_Widget)(w.getWidget().ptr()))
+}
+func (t *table) Remove(w Widget) {
+	C.go_fltk_Table_remove((*C.Fl_Table)(t.ptr()), (*C.Fl_Widget)(w.getWidget().ptr()))
+}
+
 func (t *table) RowAndColumnFromCursor() (row, col int) {
 	row = int(C.go_fltk_Table_row_from_cursor((*C.GTableRow)(t.ptr())))
 	col = int(C.go_fltk_Table_column_from_cursor((*C.GTableRow)(t.ptr())))
@@ -215,6 +240,12 @@ func (t *TableRow) SelectAllRows(flag SelectionFlag) {
 func (t *TableRow) SelectRow(row int, flag SelectionFlag) {
 	C.go_fltk_TableRow_select_row((*C.GTableRow)(t.ptr()), C.int(row), C.int(flag))
 }
+
+// RedrawRange redraws the cells from row top, column left to row bottom,
+// column right.
+func (t *TableRow) RedrawRange(top, left, bottom, right int) {
+	C.go_fltk_TableRow_redraw_range((*C.GTableRow)(t.ptr()), C.int(top), C.int(bottom), C.int(left), C.int(right))
+}
 func (t *TableRow) FindCell(ctx TableContext, row int, col int) (int, int, int, int, error) {
 	var x, y, w, h C.int
 	ret := C.go_fltk_TableRow_find_cell((*C.GTableRow)(t.ptr()), C.int(ctx), C.int(row), C.int(col), &x, &y, &w, &h)
@@ -225,6 +256,36 @@ func (t *TableRow) FindCell(ctx TableContext, row int, col int) (int, int, int,
 	return int(x), int(y), int(w), int(h), err
 }
 
+// CellFromCursor returns the part of the table under the mouse and, for a
+// cell or a header, its row and column.
+func (t *TableRow) CellFromCursor() (ctx TableContext, row, col int) {
+	var r, c C.int
+	ctx = TableContext(C.go_fltk_TableRow_cell_from_cursor((*C.GTableRow)(t.ptr()), &r, &c))
+	return ctx, int(r), int(c)
+}
+
+// Cursor returns the cell moved by keyboard navigation, or -1, -1 if there
+// is none.
+func (t *TableRow) Cursor() (row, col int) {
+	var r, c C.int
+	C.go_fltk_TableRow_cursor((*C.GTableRow)(t.ptr()), &r, &c)
+	return int(r), int(c)
+}
+
+// onResizeBorder reports whether the mouse is on a row or column border
+// that dragging resizes.
+func (t *TableRow) onResizeBorder() bool {
+	return C.go_fltk_TableRow_on_resize_border((*C.GTableRow)(t.ptr())) != 0
+}
+
+// SetCellEventHandler sets an event handler that is also passed the cell
+// the event is about: for keyboard events the cursor cell and otherwise
+// the part of the table under the mouse. As with SetEventHandler,
+// returning false lets the table handle the event as usual.
+func (t *TableRow) SetCellEventHandler(handler func(event Event, ctx TableContext, row, col int) bool) {
+	t.SetEventHandler(cellEventHandler(handler, t.Cursor, t.CellFromCursor))
+}
+
 type RowSelectMode int
 
 var (
@@ -237,6 +298,165 @@ func (t *TableRow) SetType(tableType RowSelectMode) {
 	C.go_fltk_TableRow_set_type((*C.GTableRow)(t.ptr()), C.int(tableType))
 }
 
+// Table is a table of cells that the user selects by cell ranges with the
+// mouse and navigates with the keyboard: the arrow keys, Home, End, Page Up
+// and Page Down move the cursor cell, and with Shift held they extend the
+// selection from the previous cursor cell. The cells are drawn by the draw
+// cell callback.
+type Table struct {
+	table
+	deletionHandlerId  uintptr
+	drawCellCallbackId int
+}
+
+func NewTable(x, y, w, h int) *Table {
+	t := &Table{}
+	initWidget(t, unsafe.Pointer(C.go_fltk_new_Table(C.int(x), C.int(y), C.int(w), C.int(h))))
+	t.deletionHandlerId = t.addDeletionHandler(t.onDelete)
+	return t
+}
+
+func (t *Table) onDelete() {
+	if t.deletionHandlerId > 0 {
+		globalCallbackMap.unregister(t.deletionHandlerId)
+	}
+	t.deletionHandlerId = 0
+	if t.drawCellCallbackId > 0 {
+		globalTableCallbackMap.unregister(t.drawCellCallbackId)
+	}
+	t.drawCellCallbackId = 0
+}
+func (t *Table) Destroy() {
+	if t.drawCellCallbackId > 0 {
+		globalTableCallbackMap.unregister(t.drawCellCallbackId)
+	}
+	t.drawCellCallbackId = 0
+	t.table.Destroy()
+}
+
+// SetDrawCellCallback sets the function that draws the part of the table
+// given by the context: a cell, a header, or ContextStartPage and
+// ContextEndPage around each redraw. Cells should be drawn selected when
+// IsSelected reports them so.
+func (t *Table) SetDrawCellCallback(callback func(TableContext, int, int, int, int, int, int)) {
+	if t.drawCellCallbackId > 0 {
+		globalTableCallbackMap.unregister(t.drawCellCallbackId)
+	}
+	t.drawCellCallbackId = globalTableCallbackMap.register(callback)
+	C.go_fltk_Table_set_draw_cell_callback((*C.GTable)(t.ptr()), C.int(t.drawCellCallbackId))
+}
+func (t *Table) FindCell(ctx TableContext, row int, col int) (int, int, int, int, error) {
+	var x, y, w, h C.int
+	ret := C.go_fltk_Table_find_cell((*C.GTable)(t.ptr()), C.int(ctx), C.int(row), C.int(col), &x, &y, &w, &h)
+	err := errors.New("no cell was found")
+	if ret == 0 {
+		err = nil
+	}
+	return int(x), int(y), int(w), int(h), err
+}
+
+// IsSelected reports whether the cell at row, col is selected.
+func (t *Table) IsSelected(row, col int) bool {
+	return C.go_fltk_Table_is_selected((*C.GTable)(t.ptr()), C.int(row), C.int(col)) != 0
+}
+
+// RedrawRange redraws the cells from row top, column left to row bottom,
+// column right.
+func (t *Table) RedrawRange(top, left, bottom, right int) {
+	C.go_fltk_Table_redraw_range((*C.GTable)(t.ptr()), C.int(top), C.int(bottom), C.int(left), C.int(right))
+}
+
+// CellFromCursor returns the part of the table under the mouse and, for a
+// cell or a header, its row and column.
+func (t *Table) CellFromCursor() (ctx TableContext, row, col int) {
+	var r, c C.int
+	ctx = TableContext(C.go_fltk_Table_cell_from_cursor((*C.GTable)(t.ptr()), &r, &c))
+	return ctx, int(r), int(c)
+}
+
+// RowAndColumnFromCursor returns the cell under the mouse. The row is -1 on
+// the column header and the column -1 on the row header; both are -2
+// elsewhere.
+func (t *Table) RowAndColumnFromCursor() (row, col int) {
+	ctx, row, col := t.CellFromCursor()
+	switch ctx {
+	case ContextCell:
+	case ContextColHeader:
+		row = -1
+	case ContextRowHeader:
+		col = -1
+	default:
+		row, col = -2, -2
+	}
+	return row, col
+}
+
+// Cursor returns the cell moved by keyboard navigation, or -1, -1 if there
+// is none.
+func (t *Table) Cursor() (row, col int) {
+	var r, c C.int
+	C.go_fltk_Table_cursor((*C.GTable)(t.ptr()), &r, &c)
+	return int(r), int(c)
+}
+
+// SetCursor moves the cursor to the cell at row, col, which becomes the
+// only selected cell.
+func (t *Table) SetCursor(row, col int) {
+	t.SetSelection(row, col, row, col)
+	t.Redraw()
+}
+
+// MoveCursor moves the cursor by the given number of rows and columns,
+// scrolling it into view. If extend is set, the selection is extended to
+// the new cursor cell, otherwise the cell becomes the only selected one.
+// It reports whether the cursor moved.
+func (t *Table) MoveCursor(rowDelta, colDelta int, extend bool) bool {
+	return C.go_fltk_Table_move_cursor((*C.GTable)(t.ptr()), C.int(rowDelta), C.int(colDelta), cBool(extend)) != 0
+}
+
+// SetTabCellNav sets whether Tab and Shift+Tab move the cursor between
+// cells instead of moving the focus to the next widget.
+func (t *Table) SetTabCellNav(on bool) {
+	C.go_fltk_Table_set_tab_cell_nav((*C.GTable)(t.ptr()), cBool(on))
+}
+func (t *Table) TabCellNav() bool {
+	return C.go_fltk_Table_tab_cell_nav((*C.GTable)(t.ptr())) != 0
+}
+
+// SetCellCallback sets the callback of the table to handler, which is
+// passed the part of the table, and the row and column, the callback was
+// run for. When it runs depends on SetCallbackCondition.
+func (t *Table) SetCellCallback(handler func(ctx TableContext, row, col int)) {
+	t.SetCallback(func() {
+		handler(t.CallbackContext(), t.CallbackRow(), t.CallbackColumn())
+	})
+}
+
+// SetCellEventHandler sets an event handler that is also passed the cell
+// the event is about: for keyboard events the cursor cell and otherwise
+// the part of the table under the mouse. As with SetEventHandler,
+// returning false lets the table handle the event as usual.
+func (t *Table) SetCellEventHandler(handler func(event Event, ctx TableContext, row, col int) bool) {
+	t.SetEventHandler(cellEventHandler(handler, t.Cursor, t.CellFromCursor))
+}
+
+func cellEventHandler(handler func(event Event, ctx TableContext, row, col int) bool, cursor func() (int, int), fromCursor func() (TableContext, int, int)) func(Event) bool {
+	return func(event Event) bool {
+		var ctx TableContext
+		var row, col int
+		switch event {
+		case KEYDOWN, KEYUP, SHORTCUT:
+			ctx = ContextCell
+			if row, col = cursor(); row < 0 || col < 0 {
+				ctx = ContextNone
+			}
+		default:
+			ctx, row, col = fromCursor()
+		}
+		return handler(event, ctx, row, col)
+	}
+}
+
 //export _go_drawTableHandler
 func _go_drawTableHandler(id, context, r, c, x, y, w, h C.int) {
 	globalTableCallbackMap.invoke(int(id), TableContext(context), int(r), int(c), int(x), int(y), int(w), int(h))
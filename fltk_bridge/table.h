@@ -7,10 +7,13 @@
 extern "C" {
 #endif
 
+  typedef struct Fl_Widget Fl_Widget;
   typedef struct Fl_Table Fl_Table;
   typedef struct GTableRow GTableRow;
+  typedef struct GTable GTable;
 
   extern GTableRow* go_fltk_new_TableRow(int x, int y, int w, int h);
+  extern GTable* go_fltk_new_Table(int x, int y, int w, int h);
 
   extern void go_fltk_Table_set_row_count(Fl_Table* t, int rowCount);
   extern int go_fltk_Table_row_count(Fl_Table* t);
@@ -36,15 +39,35 @@ extern "C" {
   extern void go_fltk_Table_set_row_header_width(Fl_Table* t, int size);
   extern int go_fltk_Table_column_header_height(Fl_Table* t);
   extern void go_fltk_Table_set_column_header_height(Fl_Table* t, int size);
+  extern int go_fltk_Table_column_count(Fl_Table* t);
+  extern int go_fltk_Table_row_height(Fl_Table* t, int row);
+  extern int go_fltk_Table_column_width(Fl_Table* t, int column);
+  extern void go_fltk_Table_set_selection(Fl_Table* t, int top, int left, int bottom, int right);
+  extern void go_fltk_Table_add(Fl_Table* t, Fl_Widget* w);
+  extern void go_fltk_Table_remove(Fl_Table* t, Fl_Widget* w);
 		
   extern int go_fltk_TableRow_row_selected(GTableRow* t, int row);
   extern void go_fltk_TableRow_set_draw_cell_callback(GTableRow* t, int drawCellCallback);
   extern void go_fltk_TableRow_set_type(GTableRow* t, int tableType);
   extern void go_fltk_TableRow_select_all_rows(GTableRow* t, int flag);
   extern void go_fltk_TableRow_select_row(GTableRow* t, int row, int flag);
+  extern void go_fltk_TableRow_redraw_range(GTableRow* t, int top, int bottom, int left, int right);
   extern int go_fltk_TableRow_find_cell(GTableRow* t, int ctx, int row, int col, int *x, int *y, int *w, int *h);
+  extern int go_fltk_TableRow_cell_from_cursor(GTableRow* t, int *row, int *col);
+  extern void go_fltk_TableRow_cursor(GTableRow* t, int *row, int *col);
+  extern int go_fltk_TableRow_on_resize_border(GTableRow* t);
   extern int go_fltk_Table_column_from_cursor(GTableRow* t);
   extern int go_fltk_Table_row_from_cursor(GTableRow* t);
+
+  extern void go_fltk_Table_set_draw_cell_callback(GTable* t, int drawCellCallback);
+  extern int go_fltk_Table_find_cell(GTable* t, int ctx, int row, int col, int *x, int *y, int *w, int *h);
+  extern int go_fltk_Table_cell_from_cursor(GTable* t, int *row, int *col);
+  extern void go_fltk_Table_cursor(GTable* t, int *row, int *col);
+  extern int go_fltk_Table_is_selected(GTable* t, int row, int col);
+  extern void go_fltk_Table_redraw_range(GTable* t, int top, int bottom, int left, int right);
+  extern int go_fltk_Table_move_cursor(GTable* t, int rowDelta, int colDelta, int shiftSelect);
+  extern void go_fltk_Table_set_tab_cell_nav(GTable* t, int on);
+  extern int go_fltk_Table_tab_cell_nav(GTable* t);
 		
   extern const int go_FL_CONTEXT_NONE;
   extern const int go_FL_CONTEXT_STARTPAGE;
@@ -0,0 +1,384 @@
+package fltk_bridge
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CellEditorKind is the kind of widget a cell is edited with, which also
+// decides the type of the values passed to the callbacks of a TableEditor.
+type CellEditorKind int
+
+const (
+	// EditText edits a string in an Input.
+	EditText CellEditorKind = iota
+	// EditInt edits an int in an IntInput.
+	EditInt
+	// EditFloat edits a float64 in a FloatInput.
+	EditFloat
+	// EditChoice edits a string, one of the editor's Choices, in a Choice.
+	EditChoice
+	// EditCheck edits a bool in a CheckButton.
+	EditCheck
+)
+
+// CellEditor says how a cell is edited.
+type CellEditor struct {
+	Kind CellEditorKind
+	// Choices are the values offered by an EditChoice editor.
+	Choices []string
+	// Factory, if set, makes the editor widget instead, and Kind is
+	// ignored.
+	Factory func(x, y, w, h int) CellEditorWidget
+}
+
+// CellEditorWidget is a widget that edits the value of a cell, as made by
+// the Factory of a CellEditor. The TableEditor sets its event handler to
+// notice it losing the focus.
+type CellEditorWidget interface {
+	Widget
+	// SetCellValue shows value, the value of the cell when editing starts.
+	SetCellValue(value any)
+	// CellValue returns the edited value, or an error if what was entered
+	// is not a valid value.
+	CellValue() (any, error)
+}
+
+var errNoChoice = errors.New("no choice is selected")
+
+// EditableTable is a table whose cells TableEditor edits: Table, TableRow
+// and DataTable.
+type EditableTable interface {
+	Widget
+	FindCell(ctx TableContext, row, col int) (int, int, int, int, error)
+	RedrawRange(top, left, bottom, right int)
+	Add(w Widget)
+	Remove(w Widget)
+	SetCellEventHandler(handler func(event Event, ctx TableContext, row, col int) bool)
+}
+
+var (
+	_ EditableTable = (*Table)(nil)
+	_ EditableTable = (*TableRow)(nil)
+	_ EditableTable = (*DataTable)(nil)
+)
+
+// TableEditor lets the user edit the cells of a table. Double-clicking a
+// cell, or pressing Enter or F2 on the cursor cell, opens an editor widget
+// over the cell. Enter, or moving the focus away from the editor, commits
+// the edited value; Escape cancels the edit. Arrow and Tab keys the editor
+// does not use commit the edit and then move the cursor. Ctrl+C copies the
+// selected cells, as text, to the clipboard.
+//
+// A committed value is first checked by the validator. If it is accepted,
+// the edited callback is passed the old and the new value, and is expected
+// to store the new one; the table does not keep cell values itself. If it
+// is rejected, the editor stays open and the invalid handler is told why.
+//
+// The editor uses the cell event handler and the draw handler of the table;
+// replacing them stops it from opening editors and keeping them over their
+// cells.
+type TableEditor struct {
+	table     EditableTable
+	value     func(row, col int) any
+	editorFor func(row, col int) (CellEditor, bool)
+	validate  func(row, col int, value any) error
+	onEdited  func(row, col int, old, new any)
+	onInvalid func(row, col int, err error)
+
+	// editor is the open editor, if any, editing the cell at row, col,
+	// whose value was old.
+	editor   CellEditorWidget
+	row, col int
+	old      any
+}
+
+// NewTableEditor makes the cells of t editable. value returns the value of
+// a cell, of the type its editor edits. All cells are edited as text until
+// SetEditorFunc says otherwise.
+func NewTableEditor(t EditableTable, value func(row, col int) any) *TableEditor {
+	e := &TableEditor{
+		table: t,
+		value: value,
+		editorFor: func(row, col int) (CellEditor, bool) {
+			return CellEditor{Kind: EditText}, true
+		},
+		row: -1,
+		col: -1,
+	}
+	t.SetCellEventHandler(e.onEvent)
+	t.getWidget().SetDrawHandler(func(base func()) {
+		e.place()
+		base()
+	})
+	return e
+}
+
+// SetEditorFunc sets the function that says how the cell at row, col is
+// edited, or that it cannot be edited by returning false.
+func (e *TableEditor) SetEditorFunc(editorFor func(row, col int) (CellEditor, bool)) {
+	e.editorFor = editorFor
+}
+
+// SetValidator sets the function that accepts a committed value by
+// returning nil or rejects it with an error.
+func (e *TableEditor) SetValidator(validate func(row, col int, value any) error) {
+	e.validate = validate
+}
+
+// SetEditedCallback sets the function called with the old and the new
+// value of a cell when an edit is committed.
+func (e *TableEditor) SetEditedCallback(handler func(row, col int, old, new any)) {
+	e.onEdited = handler
+}
+
+// SetInvalidHandler sets the function told why a committed value was
+// rejected, either because the editor could not read it, e.g. a number
+// that does not parse, or by the validator.
+func (e *TableEditor) SetInvalidHandler(handler func(row, col int, err error)) {
+	e.onInvalid = handler
+}
+
+// Editing returns the cell being edited, if any.
+func (e *TableEditor) Editing() (row, col int, ok bool) {
+	return e.row, e.col, e.editor != nil
+}
+
+// Edit opens an editor over the cell at row, col, first committing the
+// edit in progress, and reports whether it did.
+func (e *TableEditor) Edit(row, col int) bool {
+	if e.editor != nil && !e.Commit() {
+		return false
+	}
+	spec, ok := e.editorFor(row, col)
+	if !ok {
+		return false
+	}
+	x, y, w, h, err := e.table.FindCell(ContextCell, row, col)
+	if err != nil {
+		return false
+	}
+	editor := newCellEditorWidget(spec, x, y, w, h)
+	e.table.Add(editor)
+	e.editor, e.row, e.col = editor, row, col
+	e.old = e.value(row, col)
+	editor.SetCellValue(e.old)
+	editor.getWidget().SetEventHandler(func(event Event) bool {
+		if event == UNFOCUS {
+			// The editor must not be removed while it handles the event.
+			AddTimeout(0, func() {
+				if e.editor == editor {
+					e.commit(false)
+				}
+			})
+		}
+		return false
+	})
+	editor.getWidget().Show()
+	editor.getWidget().TakeFocus()
+	if input, ok := editor.(interface{ selectAll() }); ok {
+		input.selectAll()
+	}
+	e.table.getWidget().Redraw()
+	return true
+}
+
+// Commit ends the edit in progress, if any, passing the edited value to
+// the edited callback, and reports whether the value was accepted.
+func (e *TableEditor) Commit() bool {
+	return e.commit(true)
+}
+
+// Cancel ends the edit in progress, if any, leaving the cell as it was.
+func (e *TableEditor) Cancel() {
+	e.close(true)
+}
+
+func (e *TableEditor) commit(refocus bool) bool {
+	if e.editor == nil {
+		return true
+	}
+	row, col := e.row, e.col
+	value, err := e.editor.CellValue()
+	if err == nil && e.validate != nil {
+		err = e.validate(row, col, value)
+	}
+	if err != nil {
+		if refocus {
+			e.editor.getWidget().TakeFocus()
+		}
+		if e.onInvalid != nil {
+			e.onInvalid(row, col, err)
+		}
+		return false
+	}
+	old := e.old
+	e.close(refocus)
+	if e.onEdited != nil {
+		e.onEdited(row, col, old, value)
+	}
+	e.table.RedrawRange(row, col, row, col)
+	return true
+}
+
+// close removes the editor, giving the focus back to the table if refocus
+// is set.
+func (e *TableEditor) close(refocus bool) {
+	editor := e.editor
+	if editor == nil {
+		return
+	}
+	e.editor, e.row, e.col, e.old = nil, -1, -1, nil
+	if refocus {
+		e.table.getWidget().TakeFocus()
+	}
+	editor.getWidget().Hide()
+	e.table.Remove(editor)
+	editor.getWidget().Destroy()
+	e.table.getWidget().Redraw()
+}
+
+// place keeps the editor over its cell as the table scrolls and resizes.
+func (e *TableEditor) place() {
+	if e.editor == nil {
+		return
+	}
+	x, y, w, h, err := e.table.FindCell(ContextCell, e.row, e.col)
+	if err != nil {
+		return
+	}
+	if widget := e.editor.getWidget(); widget.X() != x || widget.Y() != y || widget.W() != w || widget.H() != h {
+		widget.Resize(x, y, w, h)
+	}
+}
+
+func (e *TableEditor) onEvent(event Event, ctx TableContext, row, col int) bool {
+	switch event {
+	case PUSH:
+		if e.editor != nil {
+			widget := e.editor.getWidget()
+			if x, y := EventX(), EventY(); x >= widget.X() && x < widget.X()+widget.W() && y >= widget.Y() && y < widget.Y()+widget.H() {
+				return false
+			}
+			if !e.Commit() {
+				return true
+			}
+		}
+		if ctx == ContextCell && EventButton() == LeftMouse && EventClicks() > 0 {
+			return e.Edit(row, col)
+		}
+	case KEYDOWN:
+		key := EventKey()
+		if e.editor != nil {
+			// Keys reach the table while editing only when the editor
+			// did not use them.
+			switch key {
+			case ENTER_KEY:
+				e.Commit()
+				return true
+			case ESCAPE:
+				e.Cancel()
+				return true
+			case UP, DOWN, LEFT, RIGHT, TAB:
+				return !e.Commit()
+			}
+			return false
+		}
+		// A DataTable copies its cells as it shows them.
+		if table, ok := e.table.(interface {
+			CopySelection(text func(row, col int) string) bool
+		}); ok && IsCopyKey(event) {
+			return table.CopySelection(func(row, col int) string {
+				return cellText(e.value(row, col))
+			})
+		}
+		if (key == ENTER_KEY || key == F2) && ctx == ContextCell {
+			return e.Edit(row, col)
+		}
+	}
+	return false
+}
+
+func newCellEditorWidget(spec CellEditor, x, y, w, h int) CellEditorWidget {
+	if spec.Factory != nil {
+		return spec.Factory(x, y, w, h)
+	}
+	switch spec.Kind {
+	case EditInt:
+		return &intCellEditor{NewIntInput(x, y, w, h)}
+	case EditFloat:
+		return &floatCellEditor{NewFloatInput(x, y, w, h)}
+	case EditChoice:
+		c := &choiceCellEditor{NewChoice(x, y, w, h), spec.Choices}
+		escape := strings.NewReplacer(`\`, `\\`, "/", `\/`, "&", "&&", "_", `\_`)
+		for _, choice := range spec.Choices {
+			c.Add(escape.Replace(choice), nil)
+		}
+		return c
+	case EditCheck:
+		return &checkCellEditor{NewCheckButton(x, y, w, h)}
+	}
+	return &textCellEditor{NewInput(x, y, w, h)}
+}
+
+func cellText(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+type textCellEditor struct{ *Input }
+
+func (c *textCellEditor) SetCellValue(value any)  { c.SetValue(cellText(value)) }
+func (c *textCellEditor) CellValue() (any, error) { return c.Value(), nil }
+func (c *textCellEditor) selectAll()              { c.SetInsertPosition(len(c.Value()), 0) }
+
+type intCellEditor struct{ *FloatInput }
+
+func (c *intCellEditor) SetCellValue(value any) { c.SetValue(cellText(value)) }
+func (c *intCellEditor) CellValue() (any, error) {
+	return strconv.Atoi(strings.TrimSpace(c.Value()))
+}
+func (c *intCellEditor) selectAll() { c.SetInsertPosition(len(c.Value()), 0) }
+
+type floatCellEditor struct{ *FloatInput }
+
+func (c *floatCellEditor) SetCellValue(value any) { c.SetValue(cellText(value)) }
+func (c *floatCellEditor) CellValue() (any, error) {
+	return strconv.ParseFloat(strings.TrimSpace(c.Value()), 64)
+}
+func (c *floatCellEditor) selectAll() { c.SetInsertPosition(len(c.Value()), 0) }
+
+type choiceCellEditor struct {
+	*Choice
+	choices []string
+}
+
+func (c *choiceCellEditor) SetCellValue(value any) {
+	text := cellText(value)
+	for i, choice := range c.choices {
+		if choice == text {
+			c.SetValue(i)
+			return
+		}
+	}
+	c.SetValue(-1)
+}
+func (c *choiceCellEditor) CellValue() (any, error) {
+	i := c.Value()
+	if i < 0 || i >= len(c.choices) {
+		return nil, errNoChoice
+	}
+	return c.choices[i], nil
+}
+
+type checkCellEditor struct{ *CheckButton }
+
+func (c *checkCellEditor) SetCellValue(value any) {
+	checked, _ := value.(bool)
+	c.SetValue(checked)
+}
+func (c *checkCellEditor) CellValue() (any, error) { return c.Value(), nil }
@@ -0,0 +1,162 @@
+package fltk_bridge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SelectionTSV returns the selected cells as tab-separated values, one line
+// per row, as spreadsheets paste them. Cells containing tabs, quotes or line
+// breaks are quoted. text returns the text of a cell.
+func (t *Table) SelectionTSV(text func(row, col int) string) string {
+	top, left, bottom, right := t.Selection()
+	if top < 0 || left < 0 {
+		return ""
+	}
+	rows := make([]int, 0, bottom-top+1)
+	for row := top; row <= bottom; row++ {
+		rows = append(rows, row)
+	}
+	var b strings.Builder
+	writeCells(&b, '\t', nil, rows, left, right, text)
+	return b.String()
+}
+
+// CopySelection copies the selected cells to the clipboard as
+// tab-separated values and reports whether any were selected.
+func (t *Table) CopySelection(text func(row, col int) string) bool {
+	return copyTSV(t.SelectionTSV(text))
+}
+
+// SelectionTSV returns all columns of the selected rows as tab-separated
+// values, one line per row, as spreadsheets paste them. Cells containing
+// tabs, quotes or line breaks are quoted. text returns the text of a cell.
+func (t *TableRow) SelectionTSV(text func(row, col int) string) string {
+	var rows []int
+	for row := 0; row < t.RowCount(); row++ {
+		if t.IsRowSelected(row) {
+			rows = append(rows, row)
+		}
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	writeCells(&b, '\t', nil, rows, 0, t.ColumnCount()-1, text)
+	return b.String()
+}
+
+// CopySelection copies the selected rows to the clipboard as tab-separated
+// values and reports whether any were selected.
+func (t *TableRow) CopySelection(text func(row, col int) string) bool {
+	return copyTSV(t.SelectionTSV(text))
+}
+
+// IsCopyKey reports whether the current event is a press of Ctrl+C, or
+// Cmd+C, for handlers that copy a table selection with CopySelection.
+func IsCopyKey(event Event) bool {
+	return (event == KEYDOWN || event == SHORTCUT) && EventState()&(CTRL|META) != 0 && EventKey() == 'c'
+}
+
+func copyTSV(tsv string) bool {
+	if tsv == "" {
+		return false
+	}
+	CopyToClipboard(tsv)
+	return true
+}
+
+// WriteTableCSV writes rows by cols cells, whose text is returned by text,
+// as CSV, first writing header as a row of its own unless it is nil.
+func WriteTableCSV(w io.Writer, header []string, rows, cols int, text func(row, col int) string) error {
+	all := make([]int, rows)
+	for row := range all {
+		all[row] = row
+	}
+	return writeCells(w, ',', header, all, 0, cols-1, text)
+}
+
+// writeCells writes columns left to right of rows, with header first, as
+// records separated by comma.
+func writeCells(w io.Writer, comma rune, header []string, rows []int, left, right int, text func(row, col int) string) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if header != nil {
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	record := make([]string, max(right-left+1, 0))
+	for _, row := range rows {
+		for i := range record {
+			record[i] = text(row, left+i)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// CSVModel is a TableModel of strings read from CSV, e.g. to show a file in
+// a DataTable. Missing cells of short rows are empty.
+type CSVModel struct {
+	// Header holds the column titles, if the CSV had a header row.
+	Header  []string
+	Rows    [][]string
+	columns int
+}
+
+// ReadCSV reads CSV text into a model, taking the first row as the column
+// titles if header is set. A leading byte order mark is skipped and bytes
+// that are not UTF-8 are replaced by U+FFFD.
+func ReadCSV(r io.Reader, header bool) (*CSVModel, error) {
+	br := bufio.NewReader(r)
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte("\ufeff")) {
+		br.Discard(3)
+	}
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	m := &CSVModel{}
+	for _, record := range records {
+		for j, field := range record {
+			if !utf8.ValidString(field) {
+				record[j] = strings.ToValidUTF8(field, "\ufffd")
+			}
+		}
+		m.columns = max(m.columns, len(record))
+	}
+	if header && len(records) > 0 {
+		m.Header, records = records[0], records[1:]
+	}
+	m.Rows = records
+	return m, nil
+}
+
+func (m *CSVModel) RowCount() int    { return len(m.Rows) }
+func (m *CSVModel) ColumnCount() int { return m.columns }
+
+func (m *CSVModel) Value(row, col int) any {
+	if record := m.Rows[row]; col < len(record) {
+		return record[col]
+	}
+	return ""
+}
+
+// ColumnSpec titles the columns from the header, or numbers them from 1.
+func (m *CSVModel) ColumnSpec(col int) ColumnSpec {
+	if col < len(m.Header) {
+		return ColumnSpec{Title: m.Header[col]}
+	}
+	return ColumnSpec{Title: strconv.Itoa(col + 1)}
+}
@@ -0,0 +1,57 @@
+package fltk_bridge
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableCSVRoundTrip(t *testing.T) {
+	cells := [][]string{
+		{"plain", "with,comma", `with "quotes"`},
+		{"multi\nline", "", "ünïcödé"},
+	}
+	var b strings.Builder
+	err := WriteTableCSV(&b, []string{"a", "b", "c"}, len(cells), 3, func(row, col int) string {
+		return cells[row][col]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := ReadCSV(strings.NewReader("\ufeff"+b.String()), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.RowCount() != len(cells) || m.ColumnCount() != 3 {
+		t.Fatalf("model is %d by %d, want %d by 3", m.RowCount(), m.ColumnCount(), len(cells))
+	}
+	if title := m.ColumnSpec(0).Title; title != "a" {
+		t.Errorf("first column title is %q, want %q", title, "a")
+	}
+	for row := range cells {
+		for col, want := range cells[row] {
+			if got := m.Value(row, col); got != want {
+				t.Errorf("cell %d,%d is %q, want %q", row, col, got, want)
+			}
+		}
+	}
+}
+
+func TestReadCSVShortRowsAndInvalidUTF8(t *testing.T) {
+	m, err := ReadCSV(strings.NewReader("x,y,z\n\xff\n"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ColumnCount() != 3 {
+		t.Errorf("column count is %d, want 3", m.ColumnCount())
+	}
+	if got := m.Value(1, 0); got != "\ufffd" {
+		t.Errorf("invalid byte read as %q, want U+FFFD", got)
+	}
+	if got := m.Value(1, 2); got != "" {
+		t.Errorf("missing cell is %q, want empty", got)
+	}
+	if title := m.ColumnSpec(2).Title; title != "3" {
+		t.Errorf("untitled column is titled %q, want %q", title, "3")
+	}
+}
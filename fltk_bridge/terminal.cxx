@@ -0,0 +1,238 @@
+#include "terminal.h"
+
+#include <FL/Fl.H>
+#include <FL/Fl_Terminal.H>
+
+#include "event_handler.h"
+
+
+class GTerminal : public EventHandler<Fl_Terminal> {
+public:
+  GTerminal(int x, int y, int w, int h, const char *label)
+    : EventHandler<Fl_Terminal>(x, y, w, h, label) {}
+
+  // Fl_Terminal keeps these protected; they are what the escape sequences
+  // for cursor movement and selection use.
+  void move_cursor(int row, int col) {
+    cursor_row(row);
+    cursor_col(col);
+  }
+  bool has_selection() const { return is_selection(); }
+  void clear_selection() {
+    clear_mouse_selection();
+    redraw();
+  }
+};
+
+GTerminal *go_fltk_new_Terminal(int x, int y, int w, int h, const char *label) {
+  return new GTerminal(x, y, w, h, label);
+}
+
+void go_fltk_Terminal_append(Fl_Terminal *t, const char *s, int len) {
+  t->append(s, len);
+}
+void go_fltk_Terminal_print_char(Fl_Terminal *t, const char *s, int len) {
+  t->print_char(s, len);
+}
+void go_fltk_Terminal_plot_char(Fl_Terminal *t, const char *s, int len, int row, int col) {
+  t->plot_char(s, len, row, col);
+}
+char *go_fltk_Terminal_text(Fl_Terminal *t, int lines_below_cursor) {
+  return (char *)t->text(lines_below_cursor != 0);
+}
+
+void go_fltk_Terminal_clear(Fl_Terminal *t) {
+  t->clear();
+}
+void go_fltk_Terminal_clear_screen(Fl_Terminal *t, int scroll_to_hist) {
+  t->clear_screen(scroll_to_hist != 0);
+}
+void go_fltk_Terminal_clear_screen_home(Fl_Terminal *t, int scroll_to_hist) {
+  t->clear_screen_home(scroll_to_hist != 0);
+}
+void go_fltk_Terminal_clear_history(Fl_Terminal *t) {
+  t->clear_history();
+}
+void go_fltk_Terminal_reset_terminal(Fl_Terminal *t) {
+  t->reset_terminal();
+}
+
+int go_fltk_Terminal_ansi(Fl_Terminal *t) {
+  return t->ansi();
+}
+void go_fltk_Terminal_set_ansi(Fl_Terminal *t, int on) {
+  t->ansi(on != 0);
+}
+int go_fltk_Terminal_output_translate(Fl_Terminal *t) {
+  return (int)t->output_translate();
+}
+void go_fltk_Terminal_set_output_translate(Fl_Terminal *t, int flags) {
+  t->output_translate((Fl_Terminal::OutFlags)flags);
+}
+int go_fltk_Terminal_show_unknown(Fl_Terminal *t) {
+  return t->show_unknown();
+}
+void go_fltk_Terminal_set_show_unknown(Fl_Terminal *t, int on) {
+  t->show_unknown(on != 0);
+}
+int go_fltk_Terminal_redraw_style(Fl_Terminal *t) {
+  return (int)t->redraw_style();
+}
+void go_fltk_Terminal_set_redraw_style(Fl_Terminal *t, int style) {
+  t->redraw_style((Fl_Terminal::RedrawStyle)style);
+}
+float go_fltk_Terminal_redraw_rate(Fl_Terminal *t) {
+  return t->redraw_rate();
+}
+void go_fltk_Terminal_set_redraw_rate(Fl_Terminal *t, float rate) {
+  t->redraw_rate(rate);
+}
+
+int go_fltk_Terminal_history_rows(Fl_Terminal *t) {
+  return t->history_rows();
+}
+void go_fltk_Terminal_set_history_rows(Fl_Terminal *t, int rows) {
+  t->history_rows(rows);
+}
+int go_fltk_Terminal_history_use(Fl_Terminal *t) {
+  return t->history_use();
+}
+int go_fltk_Terminal_display_rows(Fl_Terminal *t) {
+  return t->display_rows();
+}
+void go_fltk_Terminal_set_display_rows(Fl_Terminal *t, int rows) {
+  t->display_rows(rows);
+}
+int go_fltk_Terminal_display_columns(Fl_Terminal *t) {
+  return t->display_columns();
+}
+void go_fltk_Terminal_set_display_columns(Fl_Terminal *t, int cols) {
+  t->display_columns(cols);
+}
+
+void go_fltk_Terminal_set_margins(Fl_Terminal *t, int left, int right, int top, int bottom) {
+  t->margin_left(left);
+  t->margin_right(right);
+  t->margin_top(top);
+  t->margin_bottom(bottom);
+}
+void go_fltk_Terminal_set_hscrollbar_style(Fl_Terminal *t, int style) {
+  t->hscrollbar_style((Fl_Terminal::ScrollbarStyle)style);
+}
+
+int go_fltk_Terminal_textfont(Fl_Terminal *t) {
+  return t->textfont();
+}
+void go_fltk_Terminal_set_textfont(Fl_Terminal *t, int font) {
+  t->textfont(font);
+}
+int go_fltk_Terminal_textsize(Fl_Terminal *t) {
+  return t->textsize();
+}
+void go_fltk_Terminal_set_textsize(Fl_Terminal *t, int size) {
+  t->textsize(size);
+}
+unsigned int go_fltk_Terminal_textcolor(Fl_Terminal *t) {
+  return t->textcolor();
+}
+void go_fltk_Terminal_set_textcolor(Fl_Terminal *t, unsigned int color) {
+  t->textcolor(color);
+}
+void go_fltk_Terminal_set_color(Fl_Terminal *t, unsigned int color) {
+  t->color(color);
+}
+unsigned int go_fltk_Terminal_textfgcolor(Fl_Terminal *t) {
+  return t->textfgcolor();
+}
+void go_fltk_Terminal_set_textfgcolor(Fl_Terminal *t, unsigned int color) {
+  t->textfgcolor(color);
+}
+unsigned int go_fltk_Terminal_textbgcolor(Fl_Terminal *t) {
+  return t->textbgcolor();
+}
+void go_fltk_Terminal_set_textbgcolor(Fl_Terminal *t, unsigned int color) {
+  t->textbgcolor(color);
+}
+void go_fltk_Terminal_set_textfgcolor_default(Fl_Terminal *t, unsigned int color) {
+  t->textfgcolor_default(color);
+}
+void go_fltk_Terminal_set_textbgcolor_default(Fl_Terminal *t, unsigned int color) {
+  t->textbgcolor_default(color);
+}
+void go_fltk_Terminal_set_textfgcolor_xterm(Fl_Terminal *t, unsigned char index) {
+  t->textfgcolor_xterm(index);
+}
+void go_fltk_Terminal_set_textbgcolor_xterm(Fl_Terminal *t, unsigned char index) {
+  t->textbgcolor_xterm(index);
+}
+int go_fltk_Terminal_textattrib(Fl_Terminal *t) {
+  return t->textattrib();
+}
+void go_fltk_Terminal_set_textattrib(Fl_Terminal *t, int attrib) {
+  t->textattrib((uchar)attrib);
+}
+void go_fltk_Terminal_set_selectionfgcolor(Fl_Terminal *t, unsigned int color) {
+  t->selectionfgcolor(color);
+}
+void go_fltk_Terminal_set_selectionbgcolor(Fl_Terminal *t, unsigned int color) {
+  t->selectionbgcolor(color);
+}
+
+int go_fltk_Terminal_cursor_row(Fl_Terminal *t) {
+  return t->cursor_row();
+}
+int go_fltk_Terminal_cursor_col(Fl_Terminal *t) {
+  return t->cursor_col();
+}
+void go_fltk_Terminal_set_cursor(Fl_Terminal *t, int row, int col) {
+  ((GTerminal *)t)->move_cursor(row, col);
+}
+void go_fltk_Terminal_cursor_home(Fl_Terminal *t) {
+  t->cursor_home();
+}
+unsigned int go_fltk_Terminal_cursorfgcolor(Fl_Terminal *t) {
+  return t->cursorfgcolor();
+}
+void go_fltk_Terminal_set_cursorfgcolor(Fl_Terminal *t, unsigned int color) {
+  t->cursorfgcolor(color);
+}
+unsigned int go_fltk_Terminal_cursorbgcolor(Fl_Terminal *t) {
+  return t->cursorbgcolor();
+}
+void go_fltk_Terminal_set_cursorbgcolor(Fl_Terminal *t, unsigned int color) {
+  t->cursorbgcolor(color);
+}
+
+char *go_fltk_Terminal_selection_text(Fl_Terminal *t) {
+  return (char *)t->selection_text();
+}
+int go_fltk_Terminal_has_selection(Fl_Terminal *t) {
+  return ((GTerminal *)t)->has_selection();
+}
+void go_fltk_Terminal_clear_selection(Fl_Terminal *t) {
+  ((GTerminal *)t)->clear_selection();
+}
+void go_fltk_Terminal_paste(Fl_Terminal *t, int clipboard) {
+  Fl::paste(*t, clipboard);
+}
+
+const int go_FL_TERMINAL_NORMAL = (int)Fl_Terminal::NORMAL;
+const int go_FL_TERMINAL_BOLD = (int)Fl_Terminal::BOLD;
+const int go_FL_TERMINAL_DIM = (int)Fl_Terminal::DIM;
+const int go_FL_TERMINAL_ITALIC = (int)Fl_Terminal::ITALIC;
+const int go_FL_TERMINAL_UNDERLINE = (int)Fl_Terminal::UNDERLINE;
+const int go_FL_TERMINAL_INVERSE = (int)Fl_Terminal::INVERSE;
+const int go_FL_TERMINAL_STRIKEOUT = (int)Fl_Terminal::STRIKEOUT;
+
+const int go_FL_TERMINAL_OFF = (int)Fl_Terminal::OFF;
+const int go_FL_TERMINAL_CR_TO_LF = (int)Fl_Terminal::CR_TO_LF;
+const int go_FL_TERMINAL_LF_TO_CR = (int)Fl_Terminal::LF_TO_CR;
+const int go_FL_TERMINAL_LF_TO_CRLF = (int)Fl_Terminal::LF_TO_CRLF;
+
+const int go_FL_TERMINAL_NO_REDRAW = (int)Fl_Terminal::NO_REDRAW;
+const int go_FL_TERMINAL_RATE_LIMITED = (int)Fl_Terminal::RATE_LIMITED;
+const int go_FL_TERMINAL_PER_WRITE = (int)Fl_Terminal::PER_WRITE;
+
+const int go_FL_TERMINAL_SCROLLBAR_OFF = (int)Fl_Terminal::SCROLLBAR_OFF;
+const int go_FL_TERMINAL_SCROLLBAR_AUTO = (int)Fl_Terminal::SCROLLBAR_AUTO;
+const int go_FL_TERMINAL_SCROLLBAR_ON = (int)Fl_Terminal::SCROLLBAR_ON;
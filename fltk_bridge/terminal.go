@@ -0,0 +1,403 @@
+package fltk_bridge
+
+/*
+#include <stdlib.h>
+#include "terminal.h"
+*/
+import "C"
+import (
+	"fmt"
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// Terminal is a VT100 style text terminal with a scrollback history. Text
+// appended to it may contain ANSI escape sequences for colors, attributes
+// and cursor movement, which are interpreted while Ansi is on (the default).
+type Terminal struct {
+	widget
+
+	mu sync.Mutex
+	// pending is text written but not yet appended.
+	pending []byte
+	awake   bool
+}
+
+var _ io.Writer = (*Terminal)(nil)
+
+func NewTerminal(x, y, w, h int, text ...string) *Terminal {
+	t := &Terminal{}
+	initWidget(t, unsafe.Pointer(C.go_fltk_new_Terminal(C.int(x), C.int(y), C.int(w), C.int(h), cStringOpt(text))))
+	return t
+}
+
+// TerminalAttrib is a combination of text attributes.
+type TerminalAttrib int
+
+var (
+	TerminalNormal    = TerminalAttrib(C.go_FL_TERMINAL_NORMAL)
+	TerminalBold      = TerminalAttrib(C.go_FL_TERMINAL_BOLD)
+	TerminalDim       = TerminalAttrib(C.go_FL_TERMINAL_DIM)
+	TerminalItalic    = TerminalAttrib(C.go_FL_TERMINAL_ITALIC)
+	TerminalUnderline = TerminalAttrib(C.go_FL_TERMINAL_UNDERLINE)
+	TerminalInverse   = TerminalAttrib(C.go_FL_TERMINAL_INVERSE)
+	TerminalStrikeout = TerminalAttrib(C.go_FL_TERMINAL_STRIKEOUT)
+)
+
+// TerminalOutFlags control how line breaks in appended text are translated.
+type TerminalOutFlags int
+
+var (
+	TerminalOutputOff = TerminalOutFlags(C.go_FL_TERMINAL_OFF)
+	TerminalCrToLf    = TerminalOutFlags(C.go_FL_TERMINAL_CR_TO_LF)
+	TerminalLfToCr    = TerminalOutFlags(C.go_FL_TERMINAL_LF_TO_CR)
+	TerminalLfToCrLf  = TerminalOutFlags(C.go_FL_TERMINAL_LF_TO_CRLF)
+)
+
+// TerminalRedrawStyle determines when the terminal redraws after text was
+// appended.
+type TerminalRedrawStyle int
+
+var (
+	TerminalNoRedraw    = TerminalRedrawStyle(C.go_FL_TERMINAL_NO_REDRAW)
+	TerminalRateLimited = TerminalRedrawStyle(C.go_FL_TERMINAL_RATE_LIMITED)
+	TerminalPerWrite    = TerminalRedrawStyle(C.go_FL_TERMINAL_PER_WRITE)
+)
+
+// TerminalScrollbarStyle determines when the horizontal scrollbar is shown.
+type TerminalScrollbarStyle int
+
+var (
+	TerminalScrollbarOff  = TerminalScrollbarStyle(C.go_FL_TERMINAL_SCROLLBAR_OFF)
+	TerminalScrollbarAuto = TerminalScrollbarStyle(C.go_FL_TERMINAL_SCROLLBAR_AUTO)
+	TerminalScrollbarOn   = TerminalScrollbarStyle(C.go_FL_TERMINAL_SCROLLBAR_ON)
+)
+
+// Append adds UTF-8 text at the cursor, interpreting escape sequences if
+// Ansi is on. Escape sequences and characters may be split across calls.
+func (t *Terminal) Append(text string) {
+	if text == "" {
+		return
+	}
+	textStr := C.CString(text)
+	defer C.free(unsafe.Pointer(textStr))
+	C.go_fltk_Terminal_append((*C.Fl_Terminal)(t.ptr()), textStr, C.int(len(text)))
+}
+
+// Printf formats according to a format specifier and appends the result.
+func (t *Terminal) Printf(format string, args ...any) {
+	t.Append(fmt.Sprintf(format, args...))
+}
+
+// Write appends p, so that the terminal can be the output of a command, such
+// as the Stdout of an exec.Cmd, or a logger. It always succeeds. It may be
+// used from any goroutine once the program has called Lock: the text is
+// handed to the terminal through Awake, and so shown after text appended
+// directly with Append.
+func (t *Terminal) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = append(t.pending, p...)
+	if !t.awake {
+		t.awake = true
+		Awake(t.flush)
+	}
+	return len(p), nil
+}
+
+// flush appends the text written so far.
+func (t *Terminal) flush() {
+	t.mu.Lock()
+	output := t.pending
+	t.pending, t.awake = nil, false
+	t.mu.Unlock()
+	if len(output) > 0 && t.exists() {
+		t.Append(string(output))
+	}
+}
+
+// PrintChar prints a single character at the cursor and advances it, without
+// interpreting it as a control character.
+func (t *Terminal) PrintChar(char string) {
+	charStr := C.CString(char)
+	defer C.free(unsafe.Pointer(charStr))
+	C.go_fltk_Terminal_print_char((*C.Fl_Terminal)(t.ptr()), charStr, C.int(len(char)))
+}
+
+// PlotChar puts a single character at row and col of the display, counted
+// from 0, without moving the cursor.
+func (t *Terminal) PlotChar(char string, row, col int) {
+	charStr := C.CString(char)
+	defer C.free(unsafe.Pointer(charStr))
+	C.go_fltk_Terminal_plot_char((*C.Fl_Terminal)(t.ptr()), charStr, C.int(len(char)), C.int(row), C.int(col))
+}
+
+// Text returns the text of the history and the display, up to the cursor
+// line unless linesBelowCursor is set.
+func (t *Terminal) Text(linesBelowCursor bool) string {
+	text := C.go_fltk_Terminal_text((*C.Fl_Terminal)(t.ptr()), cBool(linesBelowCursor))
+	if text == nil {
+		return ""
+	}
+	defer C.free(unsafe.Pointer(text))
+	return C.GoString(text)
+}
+
+// Clear clears the display and the history and moves the cursor home.
+func (t *Terminal) Clear() {
+	C.go_fltk_Terminal_clear((*C.Fl_Terminal)(t.ptr()))
+}
+
+// ClearScreen clears the display, moving its lines into the history if
+// scrollToHistory is set. The cursor stays where it is.
+func (t *Terminal) ClearScreen(scrollToHistory bool) {
+	C.go_fltk_Terminal_clear_screen((*C.Fl_Terminal)(t.ptr()), cBool(scrollToHistory))
+}
+
+// ClearScreenHome is like ClearScreen but also moves the cursor home.
+func (t *Terminal) ClearScreenHome(scrollToHistory bool) {
+	C.go_fltk_Terminal_clear_screen_home((*C.Fl_Terminal)(t.ptr()), cBool(scrollToHistory))
+}
+
+func (t *Terminal) ClearHistory() {
+	C.go_fltk_Terminal_clear_history((*C.Fl_Terminal)(t.ptr()))
+}
+
+// Reset resets the terminal as the escape sequence ESC c does: the screen,
+// the history, tab stops and text attributes.
+func (t *Terminal) Reset() {
+	C.go_fltk_Terminal_reset_terminal((*C.Fl_Terminal)(t.ptr()))
+}
+
+func (t *Terminal) Ansi() bool {
+	return C.go_fltk_Terminal_ansi((*C.Fl_Terminal)(t.ptr())) != 0
+}
+
+// SetAnsi turns interpretation of ANSI escape sequences on or off.
+func (t *Terminal) SetAnsi(on bool) {
+	C.go_fltk_Terminal_set_ansi((*C.Fl_Terminal)(t.ptr()), cBool(on))
+}
+
+func (t *Terminal) OutputTranslate() TerminalOutFlags {
+	return TerminalOutFlags(C.go_fltk_Terminal_output_translate((*C.Fl_Terminal)(t.ptr())))
+}
+
+// SetOutputTranslate sets how line breaks are translated, e.g. TerminalLfToCrLf
+// (the default) so that "\n" also returns to the start of the line.
+func (t *Terminal) SetOutputTranslate(flags TerminalOutFlags) {
+	C.go_fltk_Terminal_set_output_translate((*C.Fl_Terminal)(t.ptr()), C.int(flags))
+}
+
+func (t *Terminal) ShowUnknown() bool {
+	return C.go_fltk_Terminal_show_unknown((*C.Fl_Terminal)(t.ptr())) != 0
+}
+
+// SetShowUnknown makes unknown escape sequences and invalid characters show
+// up as error characters, for debugging.
+func (t *Terminal) SetShowUnknown(on bool) {
+	C.go_fltk_Terminal_set_show_unknown((*C.Fl_Terminal)(t.ptr()), cBool(on))
+}
+
+func (t *Terminal) RedrawStyle() TerminalRedrawStyle {
+	return TerminalRedrawStyle(C.go_fltk_Terminal_redraw_style((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetRedrawStyle(style TerminalRedrawStyle) {
+	C.go_fltk_Terminal_set_redraw_style((*C.Fl_Terminal)(t.ptr()), C.int(style))
+}
+
+// RedrawRate returns the longest time in seconds between redraws with
+// TerminalRateLimited.
+func (t *Terminal) RedrawRate() float32 {
+	return float32(C.go_fltk_Terminal_redraw_rate((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetRedrawRate(seconds float32) {
+	C.go_fltk_Terminal_set_redraw_rate((*C.Fl_Terminal)(t.ptr()), C.float(seconds))
+}
+
+// HistoryRows returns how many lines scrolled off the top are kept.
+func (t *Terminal) HistoryRows() int {
+	return int(C.go_fltk_Terminal_history_rows((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetHistoryRows(rows int) {
+	C.go_fltk_Terminal_set_history_rows((*C.Fl_Terminal)(t.ptr()), C.int(rows))
+}
+
+// HistoryUse returns how many lines of the history are in use.
+func (t *Terminal) HistoryUse() int {
+	return int(C.go_fltk_Terminal_history_use((*C.Fl_Terminal)(t.ptr())))
+}
+
+// DisplayRows and DisplayColumns return the size of the screen in
+// characters, which normally follows the size of the widget.
+func (t *Terminal) DisplayRows() int {
+	return int(C.go_fltk_Terminal_display_rows((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetDisplayRows(rows int) {
+	C.go_fltk_Terminal_set_display_rows((*C.Fl_Terminal)(t.ptr()), C.int(rows))
+}
+func (t *Terminal) DisplayColumns() int {
+	return int(C.go_fltk_Terminal_display_columns((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetDisplayColumns(cols int) {
+	C.go_fltk_Terminal_set_display_columns((*C.Fl_Terminal)(t.ptr()), C.int(cols))
+}
+
+// SetMargins sets the space in pixels between the frame and the text.
+func (t *Terminal) SetMargins(left, right, top, bottom int) {
+	C.go_fltk_Terminal_set_margins((*C.Fl_Terminal)(t.ptr()), C.int(left), C.int(right), C.int(top), C.int(bottom))
+}
+
+func (t *Terminal) SetHScrollbarStyle(style TerminalScrollbarStyle) {
+	C.go_fltk_Terminal_set_hscrollbar_style((*C.Fl_Terminal)(t.ptr()), C.int(style))
+}
+
+func (t *Terminal) TextFont() Font {
+	return Font(C.go_fltk_Terminal_textfont((*C.Fl_Terminal)(t.ptr())))
+}
+
+// SetTextFont sets the font of all text; it should be a fixed width font.
+func (t *Terminal) SetTextFont(font Font) {
+	C.go_fltk_Terminal_set_textfont((*C.Fl_Terminal)(t.ptr()), C.int(font))
+}
+func (t *Terminal) TextSize() int {
+	return int(C.go_fltk_Terminal_textsize((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetTextSize(size int) {
+	C.go_fltk_Terminal_set_textsize((*C.Fl_Terminal)(t.ptr()), C.int(size))
+}
+
+// TextColor returns the default text color.
+func (t *Terminal) TextColor() Color {
+	return Color(C.go_fltk_Terminal_textcolor((*C.Fl_Terminal)(t.ptr())))
+}
+
+// SetTextColor sets the default and the current text color.
+func (t *Terminal) SetTextColor(color Color) {
+	C.go_fltk_Terminal_set_textcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// SetColor sets the background color of the widget and the default and
+// current text background.
+func (t *Terminal) SetColor(color Color) {
+	C.go_fltk_Terminal_set_color((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// TextFgColor and TextBgColor return the colors used for text appended from
+// now on.
+func (t *Terminal) TextFgColor() Color {
+	return Color(C.go_fltk_Terminal_textfgcolor((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetTextFgColor(color Color) {
+	C.go_fltk_Terminal_set_textfgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+func (t *Terminal) TextBgColor() Color {
+	return Color(C.go_fltk_Terminal_textbgcolor((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetTextBgColor(color Color) {
+	C.go_fltk_Terminal_set_textbgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// SetTextFgColorDefault and SetTextBgColorDefault set the colors the ANSI
+// reset sequence ESC [ 0 m returns to.
+func (t *Terminal) SetTextFgColorDefault(color Color) {
+	C.go_fltk_Terminal_set_textfgcolor_default((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+func (t *Terminal) SetTextBgColorDefault(color Color) {
+	C.go_fltk_Terminal_set_textbgcolor_default((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// SetTextFgColorXterm and SetTextBgColorXterm select one of the 8 xterm
+// colors (0 black to 7 white), which bold and dim make brighter and darker.
+func (t *Terminal) SetTextFgColorXterm(index uint8) {
+	C.go_fltk_Terminal_set_textfgcolor_xterm((*C.Fl_Terminal)(t.ptr()), C.uchar(index))
+}
+func (t *Terminal) SetTextBgColorXterm(index uint8) {
+	C.go_fltk_Terminal_set_textbgcolor_xterm((*C.Fl_Terminal)(t.ptr()), C.uchar(index))
+}
+
+func (t *Terminal) TextAttrib() TerminalAttrib {
+	return TerminalAttrib(C.go_fltk_Terminal_textattrib((*C.Fl_Terminal)(t.ptr())))
+}
+
+// SetTextAttrib sets the attributes of text appended from now on.
+func (t *Terminal) SetTextAttrib(attrib TerminalAttrib) {
+	C.go_fltk_Terminal_set_textattrib((*C.Fl_Terminal)(t.ptr()), C.int(attrib))
+}
+
+func (t *Terminal) SetSelectionFgColor(color Color) {
+	C.go_fltk_Terminal_set_selectionfgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+func (t *Terminal) SetSelectionBgColor(color Color) {
+	C.go_fltk_Terminal_set_selectionbgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// CursorRow and CursorCol return the cursor position on the display,
+// counted from 0.
+func (t *Terminal) CursorRow() int {
+	return int(C.go_fltk_Terminal_cursor_row((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) CursorCol() int {
+	return int(C.go_fltk_Terminal_cursor_col((*C.Fl_Terminal)(t.ptr())))
+}
+
+// SetCursor moves the cursor to row and col of the display, counted from 0.
+func (t *Terminal) SetCursor(row, col int) {
+	C.go_fltk_Terminal_set_cursor((*C.Fl_Terminal)(t.ptr()), C.int(row), C.int(col))
+}
+
+// CursorHome moves the cursor to the top left corner.
+func (t *Terminal) CursorHome() {
+	C.go_fltk_Terminal_cursor_home((*C.Fl_Terminal)(t.ptr()))
+}
+
+func (t *Terminal) CursorFgColor() Color {
+	return Color(C.go_fltk_Terminal_cursorfgcolor((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetCursorFgColor(color Color) {
+	C.go_fltk_Terminal_set_cursorfgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+func (t *Terminal) CursorBgColor() Color {
+	return Color(C.go_fltk_Terminal_cursorbgcolor((*C.Fl_Terminal)(t.ptr())))
+}
+func (t *Terminal) SetCursorBgColor(color Color) {
+	C.go_fltk_Terminal_set_cursorbgcolor((*C.Fl_Terminal)(t.ptr()), C.uint(color))
+}
+
+// HasSelection reports whether text is selected with the mouse.
+func (t *Terminal) HasSelection() bool {
+	return C.go_fltk_Terminal_has_selection((*C.Fl_Terminal)(t.ptr())) != 0
+}
+
+// SelectionText returns the selected text, or "" if nothing is selected.
+func (t *Terminal) SelectionText() string {
+	text := C.go_fltk_Terminal_selection_text((*C.Fl_Terminal)(t.ptr()))
+	if text == nil {
+		return ""
+	}
+	defer C.free(unsafe.Pointer(text))
+	return C.GoString(text)
+}
+
+// CopySelection copies the selected text to the clipboard and reports
+// whether there was any.
+func (t *Terminal) CopySelection() bool {
+	text := t.SelectionText()
+	if text == "" {
+		return false
+	}
+	CopyToClipboard(text)
+	return true
+}
+
+func (t *Terminal) ClearSelection() {
+	C.go_fltk_Terminal_clear_selection((*C.Fl_Terminal)(t.ptr()))
+}
+
+// Paste asks for the text of the clipboard, or of the selection buffer if
+// clipboard is false, to be delivered to the terminal as a PASTE event. The
+// terminal itself ignores the event; an event handler can forward
+// EventText to whatever consumes the input.
+func (t *Terminal) Paste(clipboard bool) {
+	C.go_fltk_Terminal_paste((*C.Fl_Terminal)(t.ptr()), cBool(clipboard))
+}
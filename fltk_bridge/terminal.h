@@ -0,0 +1,102 @@
+#pragma once
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+  typedef struct Fl_Terminal Fl_Terminal;
+  typedef struct GTerminal GTerminal;
+
+  extern GTerminal *go_fltk_new_Terminal(int x, int y, int w, int h, const char *label);
+
+  extern void go_fltk_Terminal_append(Fl_Terminal *t, const char *s, int len);
+  extern void go_fltk_Terminal_print_char(Fl_Terminal *t, const char *s, int len);
+  extern void go_fltk_Terminal_plot_char(Fl_Terminal *t, const char *s, int len, int row, int col);
+  extern char *go_fltk_Terminal_text(Fl_Terminal *t, int lines_below_cursor);
+
+  extern void go_fltk_Terminal_clear(Fl_Terminal *t);
+  extern void go_fltk_Terminal_clear_screen(Fl_Terminal *t, int scroll_to_hist);
+  extern void go_fltk_Terminal_clear_screen_home(Fl_Terminal *t, int scroll_to_hist);
+  extern void go_fltk_Terminal_clear_history(Fl_Terminal *t);
+  extern void go_fltk_Terminal_reset_terminal(Fl_Terminal *t);
+
+  extern int go_fltk_Terminal_ansi(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_ansi(Fl_Terminal *t, int on);
+  extern int go_fltk_Terminal_output_translate(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_output_translate(Fl_Terminal *t, int flags);
+  extern int go_fltk_Terminal_show_unknown(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_show_unknown(Fl_Terminal *t, int on);
+  extern int go_fltk_Terminal_redraw_style(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_redraw_style(Fl_Terminal *t, int style);
+  extern float go_fltk_Terminal_redraw_rate(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_redraw_rate(Fl_Terminal *t, float rate);
+
+  extern int go_fltk_Terminal_history_rows(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_history_rows(Fl_Terminal *t, int rows);
+  extern int go_fltk_Terminal_history_use(Fl_Terminal *t);
+  extern int go_fltk_Terminal_display_rows(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_display_rows(Fl_Terminal *t, int rows);
+  extern int go_fltk_Terminal_display_columns(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_display_columns(Fl_Terminal *t, int cols);
+
+  extern void go_fltk_Terminal_set_margins(Fl_Terminal *t, int left, int right, int top, int bottom);
+  extern void go_fltk_Terminal_set_hscrollbar_style(Fl_Terminal *t, int style);
+
+  extern int go_fltk_Terminal_textfont(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textfont(Fl_Terminal *t, int font);
+  extern int go_fltk_Terminal_textsize(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textsize(Fl_Terminal *t, int size);
+  extern unsigned int go_fltk_Terminal_textcolor(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textcolor(Fl_Terminal *t, unsigned int color);
+  extern void go_fltk_Terminal_set_color(Fl_Terminal *t, unsigned int color);
+  extern unsigned int go_fltk_Terminal_textfgcolor(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textfgcolor(Fl_Terminal *t, unsigned int color);
+  extern unsigned int go_fltk_Terminal_textbgcolor(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textbgcolor(Fl_Terminal *t, unsigned int color);
+  extern void go_fltk_Terminal_set_textfgcolor_default(Fl_Terminal *t, unsigned int color);
+  extern void go_fltk_Terminal_set_textbgcolor_default(Fl_Terminal *t, unsigned int color);
+  extern void go_fltk_Terminal_set_textfgcolor_xterm(Fl_Terminal *t, unsigned char index);
+  extern void go_fltk_Terminal_set_textbgcolor_xterm(Fl_Terminal *t, unsigned char index);
+  extern int go_fltk_Terminal_textattrib(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_textattrib(Fl_Terminal *t, int attrib);
+  extern void go_fltk_Terminal_set_selectionfgcolor(Fl_Terminal *t, unsigned int color);
+  extern void go_fltk_Terminal_set_selectionbgcolor(Fl_Terminal *t, unsigned int color);
+
+  extern int go_fltk_Terminal_cursor_row(Fl_Terminal *t);
+  extern int go_fltk_Terminal_cursor_col(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_cursor(Fl_Terminal *t, int row, int col);
+  extern void go_fltk_Terminal_cursor_home(Fl_Terminal *t);
+  extern unsigned int go_fltk_Terminal_cursorfgcolor(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_cursorfgcolor(Fl_Terminal *t, unsigned int color);
+  extern unsigned int go_fltk_Terminal_cursorbgcolor(Fl_Terminal *t);
+  extern void go_fltk_Terminal_set_cursorbgcolor(Fl_Terminal *t, unsigned int color);
+
+  extern char *go_fltk_Terminal_selection_text(Fl_Terminal *t);
+  extern int go_fltk_Terminal_has_selection(Fl_Terminal *t);
+  extern void go_fltk_Terminal_clear_selection(Fl_Terminal *t);
+  extern void go_fltk_Terminal_paste(Fl_Terminal *t, int clipboard);
+
+  extern const int go_FL_TERMINAL_NORMAL;
+  extern const int go_FL_TERMINAL_BOLD;
+  extern const int go_FL_TERMINAL_DIM;
+  extern const int go_FL_TERMINAL_ITALIC;
+  extern const int go_FL_TERMINAL_UNDERLINE;
+  extern const int go_FL_TERMINAL_INVERSE;
+  extern const int go_FL_TERMINAL_STRIKEOUT;
+
+  extern const int go_FL_TERMINAL_OFF;
+  extern const int go_FL_TERMINAL_CR_TO_LF;
+  extern const int go_FL_TERMINAL_LF_TO_CR;
+  extern const int go_FL_TERMINAL_LF_TO_CRLF;
+
+  extern const int go_FL_TERMINAL_NO_REDRAW;
+  extern const int go_FL_TERMINAL_RATE_LIMITED;
+  extern const int go_FL_TERMINAL_PER_WRITE;
+
+  extern const int go_FL_TERMINAL_SCROLLBAR_OFF;
+  extern const int go_FL_TERMINAL_SCROLLBAR_AUTO;
+  extern const int go_FL_TERMINAL_SCROLLBAR_ON;
+
+#ifdef __cplusplus
+}
+#endif
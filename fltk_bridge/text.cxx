@@ -1,5 +1,6 @@
 #include "text.h"
 
+#include <FL/Fl.H>
 #include <FL/Fl_Text_Display.H>
 
 #include <FL/Fl_Text_Editor.H>
@@ -115,6 +116,22 @@ void go_fltk_TextDisplay_set_linenumber_width(Fl_Text_Display *d, int width) {
   d->linenumber_width(width);
 }
 
+void go_fltk_TextDisplay_redisplay_range(Fl_Text_Display *d, int start, int end) {
+  d->redisplay_range(start, end);
+}
+
+void go_fltk_TextDisplay_scroll(Fl_Text_Display *d, int topLine, int horizOffset) {
+  d->scroll(topLine, horizOffset);
+}
+
+int go_fltk_TextDisplay_scroll_row(Fl_Text_Display *d) {
+  return d->scroll_row();
+}
+
+int go_fltk_TextDisplay_scroll_col(Fl_Text_Display *d) {
+  return d->scroll_col();
+}
+
 void go_fltk_TextDisplay_set_linenumber_font(Fl_Text_Display *d, int val) {
   d->linenumber_font(val);
 }
@@ -141,6 +158,9 @@ class GText_Editor : public EventHandler<Fl_Text_Editor> {
 public:
   GText_Editor(int x, int y, int w, int h, const char* label)
     : EventHandler<Fl_Text_Editor>(x, y, w, h, label) {}
+
+  // key_bindings is protected
+  void restore_default_key_bindings() { add_default_key_bindings(&key_bindings); }
 };
 
 GText_Editor *go_fltk_new_TextEditor(int x, int y, int w, int h, const char *text) {
@@ -179,6 +199,29 @@ int go_fltk_TextEditor_redo(Fl_Text_Editor *e) {
   return Fl_Text_Editor::kf_redo(0, e);
 }
 
+// Key_Func carries no user data, so every Go binding goes through this one
+// function and is looked up by editor, key and modifier state on the Go side.
+static int key_binding_handler(int key, Fl_Text_Editor *e) {
+  int state = Fl::event_state() & (FL_SHIFT | FL_CTRL | FL_ALT | FL_META);
+  return _go_keyBindingHandler((uintptr_t)e, key, state);
+}
+
+void go_fltk_TextEditor_add_key_binding(Fl_Text_Editor *e, int key, int state) {
+  e->add_key_binding(key, state, key_binding_handler);
+}
+
+void go_fltk_TextEditor_remove_key_binding(Fl_Text_Editor *e, int key, int state) {
+  e->remove_key_binding(key, state);
+}
+
+void go_fltk_TextEditor_remove_all_key_bindings(Fl_Text_Editor *e) {
+  e->remove_all_key_bindings();
+}
+
+void go_fltk_TextEditor_add_default_key_bindings(Fl_Text_Editor *e) {
+  ((GText_Editor*)e)->restore_default_key_bindings();
+}
+
 // --- Text Buffer ---
 
 void modify_callback_handler(int pos, int nInserted, int nDeleted, int nRestyled, const char *deletedText, void *cbArg) {
@@ -186,6 +229,11 @@ void modify_callback_handler(int pos, int nInserted, int nDeleted, int nRestyled
   _go_modifyCallbackHandler(id, pos, nInserted, nDeleted, nRestyled, (char*)deletedText);
 }
 
+void predelete_callback_handler(int pos, int nDeleted, void *cbArg) {
+  uintptr_t id = (uintptr_t)cbArg;
+  _go_predeleteCallbackHandler(id, pos, nDeleted);
+}
+
 Fl_Text_Buffer *go_fltk_new_TextBuffer(void) {
   return new Fl_Text_Buffer;
 }
@@ -198,6 +246,18 @@ void go_fltk_TextBuffer_add_modify_callback(Fl_Text_Buffer *b, uintptr_t handler
 	b->add_modify_callback(modify_callback_handler, (void*)handlerId);
 }
 
+void go_fltk_TextBuffer_remove_modify_callback(Fl_Text_Buffer *b, uintptr_t handlerId) {
+	b->remove_modify_callback(modify_callback_handler, (void*)handlerId);
+}
+
+void go_fltk_TextBuffer_add_predelete_callback(Fl_Text_Buffer *b, uintptr_t handlerId) {
+	b->add_predelete_callback(predelete_callback_handler, (void*)handlerId);
+}
+
+void go_fltk_TextBuffer_remove_predelete_callback(Fl_Text_Buffer *b, uintptr_t handlerId) {
+	b->remove_predelete_callback(predelete_callback_handler, (void*)handlerId);
+}
+
 void go_fltk_TextBuffer_set_text(Fl_Text_Buffer *b, const char *txt) {
   b->text(txt);
 }
@@ -314,6 +374,40 @@ void go_fltk_TextBuffer_set_tab_distance(Fl_Text_Buffer *b, int tabDist) {
   b->tab_distance(tabDist);
 }
 
+void go_fltk_TextBuffer_insert_bytes(Fl_Text_Buffer *b, int pos, const char *txt, int n) {
+  b->insert(pos, txt, n);
+}
+
+int go_fltk_TextBuffer_read_at(Fl_Text_Buffer *b, char *dst, int off, int n) {
+  int len = b->length();
+  if (off + n > len) n = len - off;
+  for (int i = 0; i < n; i++) dst[i] = b->byte_at(off + i);
+  return n;
+}
+
+// FLTK pops up an alert when a file had to be transcoded to UTF-8; callers
+// query input_file_was_transcoded instead.
+int go_fltk_TextBuffer_insertfile(Fl_Text_Buffer *b, const char *file, int pos) {
+  void (*action)(Fl_Text_Buffer*) = b->transcoding_warning_action;
+  b->transcoding_warning_action = NULL;
+  int ret = b->insertfile(file, pos);
+  b->transcoding_warning_action = action;
+  return ret;
+}
+
+int go_fltk_TextBuffer_loadfile(Fl_Text_Buffer *b, const char *file) {
+  b->remove(0, b->length());
+  return go_fltk_TextBuffer_insertfile(b, file, 0);
+}
+
+int go_fltk_TextBuffer_outputfile(Fl_Text_Buffer *b, const char *file, int start, int end) {
+  return b->outputfile(file, start, end);
+}
+
+int go_fltk_TextBuffer_input_file_was_transcoded(Fl_Text_Buffer *b) {
+  return b->input_file_was_transcoded;
+}
+
 void go_fltk_TextDisplay_set_highlight_data(Fl_Text_Display *self, Fl_Text_Buffer *sbuff, unsigned int *color, int *font,    
                                      int *fontsz, unsigned *attr, unsigned int *bgcolor, int sz) { 
     Fl_Text_Display::Style_Table_Entry *stable = new Fl_Text_Display::Style_Table_Entry[sz];   
@@ -323,4 +417,8 @@ void go_fltk_TextDisplay_set_highlight_data(Fl_Text_Display *self, Fl_Text_Buffe
         stable[i] = (Fl_Text_Display::Style_Table_Entry){color[i], font[i], fontsz[i], attr[i], bgcolor[i]};                       
     }                                                                                          
     self->highlight_data(sbuff, stable, sz, 'A', 0, 0);                
+}
+
+void go_fltk_TextDisplay_clear_highlight_data(Fl_Text_Display *self) {
+    self->highlight_data(NULL, NULL, 0, 'A', 0, 0);
 }                                                                                              
@@ -55,15 +55,66 @@ func _go_modifyCallbackHandler(id C.uintptr_t, pos, nInserted, nDeleted, nRestyl
 	globalModifyCallbackMap.invoke(uintptr(id), int(pos), int(nInserted), int(nDeleted), int(nRestyled), C.GoString(deletedText))
 }
 
+// --- Predelete-Callback-Map ---
+
+type predeleteCallbackMap struct {
+	cbMap map[uintptr]func(int, int)
+	id    uintptr
+}
+
+func newPredeleteCallbackMap() *predeleteCallbackMap {
+	return &predeleteCallbackMap{
+		cbMap: make(map[uintptr]func(int, int)),
+	}
+}
+func (m *predeleteCallbackMap) register(fn func(int, int)) uintptr {
+	m.id++
+	m.cbMap[m.id] = fn
+	return m.id
+}
+func (m *predeleteCallbackMap) unregister(id uintptr) {
+	delete(m.cbMap, id)
+}
+func (m *predeleteCallbackMap) invoke(id uintptr, pos, nDeleted int) {
+	if callback, ok := m.cbMap[id]; ok && callback != nil {
+		callback(pos, nDeleted)
+	}
+}
+func (m *predeleteCallbackMap) isEmpty() bool {
+	return len(m.cbMap) == 0
+}
+func (m *predeleteCallbackMap) size() int {
+	return len(m.cbMap)
+}
+func (m *predeleteCallbackMap) clear() {
+	for id := range m.cbMap {
+		delete(m.cbMap, id)
+	}
+}
+
+var globalPredeleteCallbackMap = newPredeleteCallbackMap()
+
+//export _go_predeleteCallbackHandler
+func _go_predeleteCallbackHandler(id C.uintptr_t, pos, nDeleted C.int) {
+	globalPredeleteCallbackMap.invoke(uintptr(id), int(pos), int(nDeleted))
+}
+
 type StyleTableEntry struct {
 	Color Color
 	Font  Font
 	Size  int
+	// Attr adds a background or lines to the text; BgColor is the
+	// background color used with ATTR_BGCOLOR and ATTR_BGCOLOR_EXT.
+	Attr    TextAttr
+	BgColor Color
 }
 
 type TextBuffer struct {
-	cPtr       *C.Fl_Text_Buffer
-	handlerIds []uintptr
+	cPtr         *C.Fl_Text_Buffer
+	handlerIds   []uintptr
+	predeleteIds []uintptr
+	// partial holds the start of a multi-byte character cut off by Write.
+	partial []byte
 }
 
 var ErrTextBufferDestroyed = errors.New("text buffer is destroyed")
@@ -85,11 +136,32 @@ func (b *TextBuffer) Destroy() {
 		globalModifyCallbackMap.unregister(id)
 	}
 	b.handlerIds = nil
+	for _, id := range b.predeleteIds {
+		globalPredeleteCallbackMap.unregister(id)
+	}
+	b.predeleteIds = nil
 
 	C.go_fltk_TextBuffer_delete(b.ptr())
 	b.cPtr = nil
 }
 
+// DestroyWith destroys the buffer once w is deleted, by its Destroy method
+// or together with its parent, for buffers owned by the widget showing them.
+// A display still uses its buffers while it is being deleted, so they are
+// destroyed at the next turn of the event loop. Destroying the buffer before
+// w is deleted is allowed.
+func (b *TextBuffer) DestroyWith(w Widget) {
+	var deletionHandlerId uintptr
+	deletionHandlerId = w.getWidget().addDeletionHandler(func() {
+		globalCallbackMap.unregister(deletionHandlerId)
+		AddTimeout(0, func() {
+			if b.cPtr != nil {
+				b.Destroy()
+			}
+		})
+	})
+}
+
 func (b *TextBuffer) SetText(txt string) {
 	txtstr := C.CString(txt)
 	defer C.free(unsafe.Pointer(txtstr))
@@ -154,10 +226,47 @@ func (b *TextBuffer) Length() int {
 	return int(C.go_fltk_TextBuffer_length(b.ptr()))
 }
 
-func (b *TextBuffer) AddModifyCallback(cb func(int, int, int, int, string)) {
+// TextCallbackHandle identifies a callback added to a TextBuffer.
+type TextCallbackHandle uintptr
+
+// AddModifyCallback calls cb(pos, nInserted, nDeleted, nRestyled, deletedText)
+// after every change to the buffer. Several callbacks may be added; each one
+// stays until it is removed with the returned handle or the buffer is destroyed.
+func (b *TextBuffer) AddModifyCallback(cb func(int, int, int, int, string)) TextCallbackHandle {
 	handlerId := globalModifyCallbackMap.register(cb)
 	b.handlerIds = append(b.handlerIds, handlerId)
 	C.go_fltk_TextBuffer_add_modify_callback(b.ptr(), C.uintptr_t(handlerId))
+	return TextCallbackHandle(handlerId)
+}
+
+func (b *TextBuffer) RemoveModifyCallback(h TextCallbackHandle) {
+	C.go_fltk_TextBuffer_remove_modify_callback(b.ptr(), C.uintptr_t(h))
+	globalModifyCallbackMap.unregister(uintptr(h))
+	b.handlerIds = removeHandlerId(b.handlerIds, uintptr(h))
+}
+
+// AddPredeleteCallback calls cb(pos, nDeleted) before text is removed from
+// the buffer, while the text about to go is still there.
+func (b *TextBuffer) AddPredeleteCallback(cb func(int, int)) TextCallbackHandle {
+	handlerId := globalPredeleteCallbackMap.register(cb)
+	b.predeleteIds = append(b.predeleteIds, handlerId)
+	C.go_fltk_TextBuffer_add_predelete_callback(b.ptr(), C.uintptr_t(handlerId))
+	return TextCallbackHandle(handlerId)
+}
+
+func (b *TextBuffer) RemovePredeleteCallback(h TextCallbackHandle) {
+	C.go_fltk_TextBuffer_remove_predelete_callback(b.ptr(), C.uintptr_t(h))
+	globalPredeleteCallbackMap.unregister(uintptr(h))
+	b.predeleteIds = removeHandlerId(b.predeleteIds, uintptr(h))
+}
+
+func removeHandlerId(ids []uintptr, id uintptr) []uintptr {
+	for i, v := range ids {
+		if v == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+	return ids
 }
 
 func (b *TextBuffer) Text() string {
@@ -316,6 +425,13 @@ func (t *TextDisplay) PositionToXY(pos int) (int, int) {
 	return int(x), int(y)
 }
 
+// PositionVisible reports whether pos lies on one of the lines currently
+// shown, which is when PositionToXY has a meaningful result.
+func (t *TextDisplay) PositionVisible(pos int) bool {
+	var x, y C.int
+	return C.go_fltk_TextDisplay_position_to_xy((*C.Fl_Text_Display)(t.ptr()), C.int(pos), &x, &y) != 0
+}
+
 func (t *TextDisplay) Buffer() *TextBuffer {
 	ptr := C.go_fltk_TextDisplay_buffer((*C.Fl_Text_Display)(t.ptr()))
 	if ptr == nil {
@@ -443,12 +559,40 @@ func (t *TextDisplay) SetHighlightData(buf *TextBuffer, entries []StyleTableEntr
 		colors = append(colors, C.uint(entries[i].Color))
 		fonts = append(fonts, C.int(entries[i].Font))
 		sizes = append(sizes, C.int(entries[i].Size))
-		attrs = append(attrs, 0)
-		bgcolors = append(bgcolors, 0)
+		attrs = append(attrs, C.uint(entries[i].Attr))
+		bgcolors = append(bgcolors, C.uint(entries[i].BgColor))
 	}
 	C.go_fltk_TextDisplay_set_highlight_data((*C.Fl_Text_Display)(t.ptr()), buf.ptr(), &colors[0], &fonts[0], &sizes[0], &attrs[0], &bgcolors[0], C.int(len(entries)))
 }
 
+// ClearHighlightData stops styling the text with the buffer set by
+// SetHighlightData, which may then be destroyed.
+func (t *TextDisplay) ClearHighlightData() {
+	C.go_fltk_TextDisplay_clear_highlight_data((*C.Fl_Text_Display)(t.ptr()))
+}
+
+// RedisplayRange redraws the text between start and end, e.g. after the
+// style buffer set with SetHighlightData was changed.
+func (t *TextDisplay) RedisplayRange(start, end int) {
+	C.go_fltk_TextDisplay_redisplay_range((*C.Fl_Text_Display)(t.ptr()), C.int(start), C.int(end))
+}
+
+// Scroll scrolls the text so that line topLine, counted from 1, is at the
+// top and the text is shifted left by horizOffset pixels.
+func (t *TextDisplay) Scroll(topLine, horizOffset int) {
+	C.go_fltk_TextDisplay_scroll((*C.Fl_Text_Display)(t.ptr()), C.int(topLine), C.int(horizOffset))
+}
+
+// ScrollRow returns the number of the line at the top, counted from 1.
+func (t *TextDisplay) ScrollRow() int {
+	return int(C.go_fltk_TextDisplay_scroll_row((*C.Fl_Text_Display)(t.ptr())))
+}
+
+// ScrollCol returns the horizontal scroll offset in pixels.
+func (t *TextDisplay) ScrollCol() int {
+	return int(C.go_fltk_TextDisplay_scroll_col((*C.Fl_Text_Display)(t.ptr())))
+}
+
 // SetLinenumberWidth enabled/disables and sets the width used by line numbers.
 //
 // A width of 0 pixels disables line numbers. A width > 0 enables line
@@ -21,6 +21,10 @@ extern "C" {
   extern void go_fltk_TextEditor_select_all(Fl_Text_Editor *e);
   extern int go_fltk_TextEditor_undo(Fl_Text_Editor *e);
   extern int go_fltk_TextEditor_redo(Fl_Text_Editor *e);
+  extern void go_fltk_TextEditor_add_key_binding(Fl_Text_Editor *e, int key, int state);
+  extern void go_fltk_TextEditor_remove_key_binding(Fl_Text_Editor *e, int key, int state);
+  extern void go_fltk_TextEditor_remove_all_key_bindings(Fl_Text_Editor *e);
+  extern void go_fltk_TextEditor_add_default_key_bindings(Fl_Text_Editor *e);
 
   extern GText_Display *go_fltk_new_TextDisplay(int x, int y, int w, int h, const char *text);
   extern void go_fltk_TextDisplay_set_buffer(Fl_Text_Display *d, Fl_Text_Buffer *buf);
@@ -50,9 +54,17 @@ extern "C" {
   extern void go_fltk_TextDisplay_set_linenumber_fgcolor(Fl_Text_Display *d, unsigned int val);
   extern void go_fltk_TextDisplay_set_linenumber_bgcolor(Fl_Text_Display *d, unsigned int val);
   extern void go_fltk_TextDisplay_set_linenumber_align(Fl_Text_Display *d, int val);
+  extern void go_fltk_TextDisplay_redisplay_range(Fl_Text_Display *d, int start, int end);
+  extern void go_fltk_TextDisplay_scroll(Fl_Text_Display *d, int topLine, int horizOffset);
+  extern int go_fltk_TextDisplay_scroll_row(Fl_Text_Display *d);
+  extern int go_fltk_TextDisplay_scroll_col(Fl_Text_Display *d);
+  extern void go_fltk_TextDisplay_clear_highlight_data(Fl_Text_Display *d);
 
   extern Fl_Text_Buffer *go_fltk_new_TextBuffer(void);
   extern void go_fltk_TextBuffer_add_modify_callback(Fl_Text_Buffer *b, uintptr_t handlerId);
+  extern void go_fltk_TextBuffer_remove_modify_callback(Fl_Text_Buffer *b, uintptr_t handlerId);
+  extern void go_fltk_TextBuffer_add_predelete_callback(Fl_Text_Buffer *b, uintptr_t handlerId);
+  extern void go_fltk_TextBuffer_remove_predelete_callback(Fl_Text_Buffer *b, uintptr_t handlerId);
   extern void go_fltk_TextBuffer_delete(Fl_Text_Buffer* b);
   extern void go_fltk_TextBuffer_set_text(Fl_Text_Buffer *b, const char *txt);
   extern void go_fltk_TextBuffer_append(Fl_Text_Buffer *b, const char *txt);
@@ -83,6 +95,12 @@ extern "C" {
   extern void go_fltk_TextBuffer_unselect(Fl_Text_Buffer *b);
   extern int go_fltk_TextBuffer_tab_distance(Fl_Text_Buffer *b);
   extern void go_fltk_TextBuffer_set_tab_distance(Fl_Text_Buffer *b, int tabDist);
+  extern void go_fltk_TextBuffer_insert_bytes(Fl_Text_Buffer *b, int pos, const char *txt, int n);
+  extern int go_fltk_TextBuffer_read_at(Fl_Text_Buffer *b, char *dst, int off, int n);
+  extern int go_fltk_TextBuffer_loadfile(Fl_Text_Buffer *b, const char *file);
+  extern int go_fltk_TextBuffer_insertfile(Fl_Text_Buffer *b, const char *file, int pos);
+  extern int go_fltk_TextBuffer_outputfile(Fl_Text_Buffer *b, const char *file, int start, int end);
+  extern int go_fltk_TextBuffer_input_file_was_transcoded(Fl_Text_Buffer *b);
   extern void go_fltk_TextDisplay_set_highlight_data(
       Fl_Text_Display *d, Fl_Text_Buffer *sbuff, unsigned int *color,
       int *font, int *fontsz, unsigned *attr, unsigned int *bgcolor, int sz);
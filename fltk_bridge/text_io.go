@@ -0,0 +1,215 @@
+package fltk_bridge
+
+/*
+#include <stdlib.h>
+#include "text.h"
+*/
+import "C"
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"syscall"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+var (
+	ErrTextFileOpen   = errors.New("cannot open file")
+	ErrTextFileRead   = errors.New("cannot read file")
+	ErrTextFileWrite  = errors.New("cannot write file")
+	ErrNegativeOffset = errors.New("negative offset")
+)
+
+// TextDecoder converts the raw contents of a file to UTF-8.
+type TextDecoder func(data []byte) ([]byte, error)
+
+// DecodeLatin1 treats data as ISO 8859-1.
+func DecodeLatin1(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	for _, c := range data {
+		out = utf8.AppendRune(out, rune(c))
+	}
+	return out, nil
+}
+
+// DecodeUTF16 treats data as UTF-16. The byte order is taken from the byte
+// order mark and defaults to little endian.
+func DecodeUTF16(data []byte) ([]byte, error) {
+	bigEndian := false
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		bigEndian = true
+		data = data[2:]
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		data = data[2:]
+	}
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+		} else {
+			units[i] = uint16(data[2*i+1])<<8 | uint16(data[2*i])
+		}
+	}
+	out := make([]byte, 0, len(data))
+	for _, r := range utf16.Decode(units) {
+		out = utf8.AppendRune(out, r)
+	}
+	return out, nil
+}
+
+// DecodeAuto picks the encoding from the byte order mark. Without one, valid
+// UTF-8 is kept as is and anything else is read as Latin-1.
+func DecodeAuto(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return data[3:], nil
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}), bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return DecodeUTF16(data)
+	case utf8.Valid(data):
+		return data, nil
+	}
+	return DecodeLatin1(data)
+}
+
+func textFileError(op, path string, ret C.int, errno error, failure error) error {
+	err := failure
+	if ret == 1 {
+		err = ErrTextFileOpen
+	}
+	if e, ok := errno.(syscall.Errno); ok && e != 0 {
+		err = e
+	}
+	return &fs.PathError{Op: op, Path: path, Err: err}
+}
+
+// LoadFile replaces the contents of the buffer with the file at path.
+// Without a decoder FLTK reads the file itself, falling back to Latin-1
+// for bytes that are not valid UTF-8 (see InputFileWasTranscoded).
+func (b *TextBuffer) LoadFile(path string, decode ...TextDecoder) error {
+	if len(decode) > 0 {
+		data, err := readDecoded(path, decode[0])
+		if err != nil {
+			return err
+		}
+		b.Remove(0, b.Length())
+		b.insertBytes(0, data)
+		return nil
+	}
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	if ret, errno := C.go_fltk_TextBuffer_loadfile(b.ptr(), fileStr); ret != 0 {
+		return textFileError("load", path, ret, errno, ErrTextFileRead)
+	}
+	return nil
+}
+
+// AppendFile adds the file at path to the end of the buffer.
+func (b *TextBuffer) AppendFile(path string, decode ...TextDecoder) error {
+	return b.InsertFile(path, b.Length(), decode...)
+}
+
+// InsertFile inserts the file at path at byte position pos.
+func (b *TextBuffer) InsertFile(path string, pos int, decode ...TextDecoder) error {
+	if len(decode) > 0 {
+		data, err := readDecoded(path, decode[0])
+		if err != nil {
+			return err
+		}
+		b.insertBytes(pos, data)
+		return nil
+	}
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	if ret, errno := C.go_fltk_TextBuffer_insertfile(b.ptr(), fileStr, C.int(pos)); ret != 0 {
+		return textFileError("insert", path, ret, errno, ErrTextFileRead)
+	}
+	return nil
+}
+
+// SaveFile writes the whole buffer to path as UTF-8.
+func (b *TextBuffer) SaveFile(path string) error {
+	return b.OutputFile(path, 0, b.Length())
+}
+
+// OutputFile writes the bytes from start to end to path as UTF-8.
+func (b *TextBuffer) OutputFile(path string, start, end int) error {
+	fileStr := C.CString(path)
+	defer C.free(unsafe.Pointer(fileStr))
+	if ret, errno := C.go_fltk_TextBuffer_outputfile(b.ptr(), fileStr, C.int(start), C.int(end)); ret != 0 {
+		return textFileError("save", path, ret, errno, ErrTextFileWrite)
+	}
+	return nil
+}
+
+// InputFileWasTranscoded reports whether the last file loaded by FLTK
+// contained bytes that were not valid UTF-8 and had to be converted.
+func (b *TextBuffer) InputFileWasTranscoded() bool {
+	return C.go_fltk_TextBuffer_input_file_was_transcoded(b.ptr()) != 0
+}
+
+func readDecoded(path string, decode TextDecoder) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+func (b *TextBuffer) insertBytes(pos int, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	C.go_fltk_TextBuffer_insert_bytes(b.ptr(), C.int(pos), (*C.char)(unsafe.Pointer(&data[0])), C.int(len(data)))
+}
+
+// Write appends p to the buffer. A multi-byte character split between two
+// writes is held back until it is complete, so io.Copy never tears one apart.
+func (b *TextBuffer) Write(p []byte) (int, error) {
+	data := append(b.partial, p...)
+	cut := len(data)
+	for k := 1; k < utf8.UTFMax && k <= len(data); k++ {
+		if utf8.RuneStart(data[len(data)-k]) {
+			if !utf8.FullRune(data[len(data)-k:]) {
+				cut = len(data) - k
+			}
+			break
+		}
+	}
+	b.insertBytes(b.Length(), data[:cut])
+	b.partial = append([]byte(nil), data[cut:]...)
+	return len(p), nil
+}
+
+// WriteString appends s to the buffer.
+func (b *TextBuffer) WriteString(s string) (int, error) {
+	return b.Write([]byte(s))
+}
+
+// ReadAt copies the bytes of the buffer starting at off into p.
+func (b *TextBuffer) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+	if off >= int64(b.Length()) {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := int(C.go_fltk_TextBuffer_read_at(b.ptr(), (*C.char)(unsafe.Pointer(&p[0])), C.int(off), C.int(len(p))))
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+var (
+	_ io.Writer       = (*TextBuffer)(nil)
+	_ io.StringWriter = (*TextBuffer)(nil)
+	_ io.ReaderAt     = (*TextBuffer)(nil)
+)
@@ -0,0 +1,77 @@
+package fltk_bridge
+
+import "testing"
+
+func TestDecodeUTF16(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"little endian BOM", "\xff\xfeh\x00i\x00", "hi"},
+		{"big endian BOM", "\xfe\xff\x00h\x00i", "hi"},
+		{"no BOM", "h\x00i\x00", "hi"},
+		{"surrogate pair", "\xff\xfe\x3d\xd8\x00\xde", "\U0001F600"},
+		{"big endian surrogate pair", "\xfe\xff\xd8\x3d\xde\x00", "\U0001F600"},
+		{"unpaired surrogate", "\xff\xfe\x3d\xd8a\x00", "\ufffda"},
+		{"odd length", "\xff\xfeh\x00i", "h"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		got, err := DecodeUTF16([]byte(tt.data))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if string(got) != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeAuto(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"UTF-8 BOM", "\xef\xbb\xbfh\xc3\xa9", "hé"},
+		{"UTF-16LE BOM", "\xff\xfeh\x00\xe9\x00", "hé"},
+		{"UTF-16BE BOM", "\xfe\xff\x00h\x00\xe9", "hé"},
+		{"UTF-8", "h\xc3\xa9", "hé"},
+		{"Latin-1", "h\xe9", "hé"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		got, err := DecodeAuto([]byte(tt.data))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if string(got) != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTextBufferWriteHoldsBackPartialRunes(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		// want is the text after each write.
+		want []string
+	}{
+		{"ASCII", []string{"ab", "c"}, []string{"ab", "abc"}},
+		{"two byte rune split", []string{"h\xc3", "\xa9!"}, []string{"h", "hé!"}},
+		{"four byte rune split", []string{"\xf0\x9f", "\x98", "\x80"}, []string{"", "", "\U0001F600"}},
+		{"rune at end of write", []string{"x\xf0\x9f\x98\x80"}, []string{"x\U0001F600"}},
+	}
+	for _, tt := range tests {
+		buf := NewTextBuffer()
+		for i, w := range tt.writes {
+			if n, err := buf.Write([]byte(w)); n != len(w) || err != nil {
+				t.Errorf("%s: write %d returned %d, %v", tt.name, i, n, err)
+			}
+			if got := buf.Text(); got != tt.want[i] {
+				t.Errorf("%s: text after write %d is %q, want %q", tt.name, i, got, tt.want[i])
+			}
+		}
+		buf.Destroy()
+	}
+}
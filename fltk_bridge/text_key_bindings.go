@@ -0,0 +1,218 @@
+package fltk_bridge
+
+/*
+#include <stdint.h>
+#include "text.h"
+*/
+import "C"
+import "unsafe"
+
+// --- Key-Binding-Map ---
+
+type keyCombo struct {
+	key, state int
+}
+
+// keyBindingMap holds the Go key bindings of every TextEditor, keyed by the
+// address of the C++ editor.
+type keyBindingMap struct {
+	editors map[uintptr]map[keyCombo]func() bool
+}
+
+func newKeyBindingMap() *keyBindingMap {
+	return &keyBindingMap{
+		editors: make(map[uintptr]map[keyCombo]func() bool),
+	}
+}
+
+// register binds fn and reports whether this is the first binding ever made
+// for the editor.
+func (m *keyBindingMap) register(editor uintptr, combo keyCombo, fn func() bool) bool {
+	bindings, ok := m.editors[editor]
+	if !ok {
+		bindings = make(map[keyCombo]func() bool)
+		m.editors[editor] = bindings
+	}
+	bindings[combo] = fn
+	return !ok
+}
+func (m *keyBindingMap) unregister(editor uintptr, combo keyCombo) {
+	delete(m.editors[editor], combo)
+}
+func (m *keyBindingMap) clear(editor uintptr) {
+	if bindings, ok := m.editors[editor]; ok {
+		for combo := range bindings {
+			delete(bindings, combo)
+		}
+	}
+}
+func (m *keyBindingMap) removeEditor(editor uintptr) {
+	delete(m.editors, editor)
+}
+func (m *keyBindingMap) invoke(editor uintptr, combo keyCombo) bool {
+	if fn, ok := m.editors[editor][combo]; ok && fn != nil {
+		return fn()
+	}
+	return false
+}
+
+var globalKeyBindingMap = newKeyBindingMap()
+
+//export _go_keyBindingHandler
+func _go_keyBindingHandler(editor C.uintptr_t, key, state C.int) C.int {
+	if globalKeyBindingMap.invoke(uintptr(editor), keyCombo{int(key), int(state)}) {
+		return 1
+	}
+	return 0
+}
+
+// AddKeyBinding calls handler when key is pressed with exactly the modifiers
+// in state (a combination of SHIFT, CTRL, ALT and META). Letter keys are
+// given in lower case. The handler returns true if it handled the key.
+// A binding replaces any earlier one, built-in or not, for the same keys.
+func (t *TextEditor) AddKeyBinding(key, state int, handler func() bool) {
+	editor := uintptr(unsafe.Pointer(t.ptr()))
+	if globalKeyBindingMap.register(editor, keyCombo{key, state}, handler) {
+		var deletionHandlerId uintptr
+		deletionHandlerId = t.addDeletionHandler(func() {
+			globalKeyBindingMap.removeEditor(editor)
+			globalCallbackMap.unregister(deletionHandlerId)
+		})
+	}
+	C.go_fltk_TextEditor_add_key_binding((*C.Fl_Text_Editor)(t.ptr()), C.int(key), C.int(state))
+}
+
+// RemoveKeyBinding removes the binding for key and state, which may also be
+// one of the editor's built-in bindings.
+func (t *TextEditor) RemoveKeyBinding(key, state int) {
+	globalKeyBindingMap.unregister(uintptr(unsafe.Pointer(t.ptr())), keyCombo{key, state})
+	C.go_fltk_TextEditor_remove_key_binding((*C.Fl_Text_Editor)(t.ptr()), C.int(key), C.int(state))
+}
+
+// RemoveAllKeyBindings removes every binding of the editor, including the
+// built-in ones for cursor movement, editing and the clipboard; only typing
+// text keeps working. Use AddDefaultKeyBindings to get those back.
+func (t *TextEditor) RemoveAllKeyBindings() {
+	globalKeyBindingMap.clear(uintptr(unsafe.Pointer(t.ptr())))
+	C.go_fltk_TextEditor_remove_all_key_bindings((*C.Fl_Text_Editor)(t.ptr()))
+}
+
+// AddDefaultKeyBindings adds FLTK's built-in key bindings to the editor.
+func (t *TextEditor) AddDefaultKeyBindings() {
+	C.go_fltk_TextEditor_add_default_key_bindings((*C.Fl_Text_Editor)(t.ptr()))
+}
+
+// UseEmacsKeyBindings adds Emacs style line editing: Ctrl+A/E to the start
+// and end of the line, Ctrl+F/B/N/P to move by character and line, Ctrl+D to
+// delete the next character, Ctrl+K to kill to the end of the line (or the
+// line break when already there) into the clipboard and Ctrl+Y to yank it.
+func (t *TextEditor) UseEmacsKeyBindings() {
+	move := func(to func(b *TextBuffer, pos int) int) func() bool {
+		return t.editLine(func(b *TextBuffer, pos int) {
+			t.SetInsertPosition(to(b, pos))
+		})
+	}
+	t.AddKeyBinding('a', CTRL, move((*TextBuffer).LineStart))
+	t.AddKeyBinding('e', CTRL, move((*TextBuffer).LineEnd))
+	t.AddKeyBinding('f', CTRL, func() bool { t.MoveRight(); return true })
+	t.AddKeyBinding('b', CTRL, func() bool { t.MoveLeft(); return true })
+	t.AddKeyBinding('n', CTRL, func() bool { t.MoveDown(); return true })
+	t.AddKeyBinding('p', CTRL, func() bool { t.MoveUp(); return true })
+	t.AddKeyBinding('d', CTRL, t.editLine(func(b *TextBuffer, pos int) {
+		if pos < b.Length() {
+			b.Remove(pos, b.NextChar(pos))
+		}
+	}))
+	t.AddKeyBinding('k', CTRL, t.editLine(func(b *TextBuffer, pos int) {
+		end := b.LineEnd(pos)
+		if end == pos && end < b.Length() {
+			end++
+		}
+		if end > pos {
+			CopyToClipboard(b.GetTextRange(pos, end))
+			b.Remove(pos, end)
+		}
+	}))
+	t.AddKeyBinding('y', CTRL, func() bool { t.Paste(); return true })
+}
+
+// UseVSCodeKeyBindings adds the line commands of Visual Studio Code:
+// Alt+Up/Down to move the line, Shift+Alt+Up/Down to duplicate it,
+// Ctrl+Shift+K to delete it, Ctrl+Enter and Ctrl+Shift+Enter to open a line
+// below or above and Ctrl+L to select it.
+func (t *TextEditor) UseVSCodeKeyBindings() {
+	t.AddKeyBinding(UP, ALT, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		if start == 0 {
+			return
+		}
+		prevStart := b.LineStart(start - 1)
+		line, prev := b.GetTextRange(start, end), b.GetTextRange(prevStart, start-1)
+		b.ReplaceRange(prevStart, end, line+"\n"+prev)
+		t.SetInsertPosition(prevStart + pos - start)
+	}))
+	t.AddKeyBinding(DOWN, ALT, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		if end >= b.Length() {
+			return
+		}
+		nextEnd := b.LineEnd(end + 1)
+		line, next := b.GetTextRange(start, end), b.GetTextRange(end+1, nextEnd)
+		b.ReplaceRange(start, nextEnd, next+"\n"+line)
+		t.SetInsertPosition(start + len(next) + 1 + pos - start)
+	}))
+	t.AddKeyBinding(UP, SHIFT|ALT, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		b.Insert(start, b.GetTextRange(start, end)+"\n")
+		t.SetInsertPosition(pos)
+	}))
+	t.AddKeyBinding(DOWN, SHIFT|ALT, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		line := b.GetTextRange(start, end)
+		b.Insert(end, "\n"+line)
+		t.SetInsertPosition(pos + len(line) + 1)
+	}))
+	t.AddKeyBinding('k', CTRL|SHIFT, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		switch {
+		case end < b.Length():
+			end++
+		case start > 0:
+			start--
+		}
+		b.Remove(start, end)
+		t.SetInsertPosition(b.LineStart(min(start, b.Length())))
+	}))
+	t.AddKeyBinding(ENTER_KEY, CTRL, t.editLine(func(b *TextBuffer, pos int) {
+		end := b.LineEnd(pos)
+		b.Insert(end, "\n")
+		t.SetInsertPosition(end + 1)
+	}))
+	t.AddKeyBinding(ENTER_KEY, CTRL|SHIFT, t.editLine(func(b *TextBuffer, pos int) {
+		start := b.LineStart(pos)
+		b.Insert(start, "\n")
+		t.SetInsertPosition(start)
+	}))
+	t.AddKeyBinding('l', CTRL, t.editLine(func(b *TextBuffer, pos int) {
+		start, end := b.LineStart(pos), b.LineEnd(pos)
+		if end < b.Length() {
+			end++
+		}
+		b.Select(start, end)
+		t.SetInsertPosition(end)
+	}))
+}
+
+// editLine turns fn into a key handler that gets the buffer and the cursor
+// position and scrolls the cursor into view afterwards.
+func (t *TextEditor) editLine(fn func(b *TextBuffer, pos int)) func() bool {
+	return func() bool {
+		b := t.Buffer()
+		if b == nil {
+			return false
+		}
+		fn(b, t.GetInsertPosition())
+		t.ShowInsertPosition()
+		return true
+	}
+}
@@ -0,0 +1,84 @@
+package fltk_bridge
+
+import "testing"
+
+func TestKeyBindingMap(t *testing.T) {
+	m := newKeyBindingMap()
+	const editor, other = uintptr(1), uintptr(2)
+	ctrlA := keyCombo{'a', CTRL}
+	ctrlE := keyCombo{'e', CTRL}
+
+	var calls []string
+	bind := func(name string, handled bool) func() bool {
+		return func() bool { calls = append(calls, name); return handled }
+	}
+
+	if !m.register(editor, ctrlA, bind("a", true)) {
+		t.Error("register of the first binding did not report it")
+	}
+	if m.register(editor, ctrlE, bind("e", false)) {
+		t.Error("register of a second binding reported it as the first")
+	}
+	if !m.register(other, ctrlA, bind("other", true)) {
+		t.Error("register of the first binding of another editor did not report it")
+	}
+
+	steps := []struct {
+		editor uintptr
+		combo  keyCombo
+		want   bool
+		call   string
+	}{
+		{editor, ctrlA, true, "a"},
+		{editor, ctrlE, false, "e"},
+		{editor, keyCombo{'a', ALT}, false, ""},
+		{other, ctrlA, true, "other"},
+		{uintptr(3), ctrlA, false, ""},
+	}
+	for _, step := range steps {
+		calls = nil
+		if got := m.invoke(step.editor, step.combo); got != step.want {
+			t.Errorf("invoke(%d, %v) = %v, want %v", step.editor, step.combo, got, step.want)
+		}
+		if step.call == "" && len(calls) != 0 || step.call != "" && (len(calls) != 1 || calls[0] != step.call) {
+			t.Errorf("invoke(%d, %v) called %v, want %q", step.editor, step.combo, calls, step.call)
+		}
+	}
+
+	// A new binding replaces the old one for the same keys.
+	m.register(editor, ctrlA, bind("a2", false))
+	calls = nil
+	if m.invoke(editor, ctrlA) || len(calls) != 1 || calls[0] != "a2" {
+		t.Errorf("replaced binding called %v", calls)
+	}
+
+	m.unregister(editor, ctrlA)
+	if m.invoke(editor, ctrlA) {
+		t.Error("unregistered binding still handled its keys")
+	}
+	m.unregister(uintptr(3), ctrlA)
+
+	// Clearing keeps the editor known, so no second deletion handler is added.
+	m.clear(editor)
+	if m.invoke(editor, ctrlE) {
+		t.Error("binding still handled its keys after clear")
+	}
+	if m.register(editor, ctrlE, bind("e", true)) {
+		t.Error("register after clear reported the first binding")
+	}
+	if !m.invoke(other, ctrlA) {
+		t.Error("clear removed the bindings of another editor")
+	}
+
+	// Once the editor is deleted its next binding is a first one again.
+	m.removeEditor(editor)
+	if _, ok := m.editors[editor]; ok {
+		t.Error("removeEditor left the editor in the map")
+	}
+	if m.invoke(editor, ctrlE) {
+		t.Error("binding of a removed editor still handled its keys")
+	}
+	if !m.register(editor, ctrlE, bind("e", true)) {
+		t.Error("register after removeEditor did not report the first binding")
+	}
+}
@@ -0,0 +1,136 @@
+package fltk_bridge
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// FindRegexp returns the byte range of the first match of re starting at or
+// after from, which may overlap a match starting before from. Matches are
+// found with the text before them in view, so that ^ and \b hold where they
+// would in the whole text.
+func (b *TextBuffer) FindRegexp(re *regexp.Regexp, from int) (start, end int, found bool) {
+	return findRegexp(re, b.Text(), from)
+}
+
+// FindRegexpBackward returns the byte range of the match of re starting last
+// among those that end at or before pos, finding matches like FindRegexp.
+func (b *TextBuffer) FindRegexpBackward(re *regexp.Regexp, pos int) (start, end int, found bool) {
+	return findRegexpBackward(re, b.Text(), pos)
+}
+
+// anchoredRegexp returns a regexp matching one rune and then re, used to
+// match re at a position with the rune before it in view.
+func anchoredRegexp(re *regexp.Regexp) *regexp.Regexp {
+	return regexp.MustCompile(`\A[\s\S](?:` + re.String() + `)`)
+}
+
+func findRegexp(re *regexp.Regexp, text string, from int) (start, end int, found bool) {
+	return findRegexpFrom(re, anchoredRegexp(re), text, from)
+}
+
+// findRegexpFrom is findRegexp with at, the anchoredRegexp of re.
+func findRegexpFrom(re, at *regexp.Regexp, text string, from int) (start, end int, found bool) {
+	if from <= 0 {
+		if loc := re.FindStringIndex(text); loc != nil {
+			return loc[0], loc[1], true
+		}
+		return 0, 0, false
+	}
+	for pos := from; pos <= len(text); {
+		if loc := at.FindStringIndex(text[pos-1:]); loc != nil {
+			return pos, pos - 1 + loc[1], true
+		}
+		// No match starts at pos, so one found there in text[pos:], which
+		// does not show the rune before pos, is wrong; a later one is right.
+		loc := re.FindStringIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		if loc[0] > 0 {
+			return pos + loc[0], pos + loc[1], true
+		}
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += max(size, 1)
+	}
+	return 0, 0, false
+}
+
+func findRegexpBackward(re *regexp.Regexp, text string, pos int) (start, end int, found bool) {
+	at := anchoredRegexp(re)
+	for from := 0; from <= pos; {
+		s, e, ok := findRegexpFrom(re, at, text, from)
+		if !ok || s > pos {
+			break
+		}
+		if e <= pos {
+			start, end, found = s, e, true
+		}
+		_, size := utf8.DecodeRuneInString(text[s:])
+		from = s + max(size, 1)
+	}
+	return start, end, found
+}
+
+// submatchAt returns the submatch indexes of the match of re in text that
+// spans start to end, or nil if there is none.
+func submatchAt(re *regexp.Regexp, text string, start, end int) []int {
+	if start <= 0 {
+		if loc := re.FindStringSubmatchIndex(text); loc != nil && loc[0] == 0 && loc[1] == end {
+			return loc
+		}
+		return nil
+	}
+	loc := anchoredRegexp(re).FindStringSubmatchIndex(text[start-1:])
+	if loc == nil || start-1+loc[1] != end {
+		return nil
+	}
+	loc[0]++
+	for i := range loc {
+		if loc[i] >= 0 {
+			loc[i] += start - 1
+		}
+	}
+	return loc
+}
+
+// FindAll returns the byte ranges of all matches of re, in the form of
+// regexp.FindAllStringIndex.
+func (b *TextBuffer) FindAll(re *regexp.Regexp) [][]int {
+	return re.FindAllStringIndex(b.Text(), -1)
+}
+
+// ReplaceAll replaces every match of re with repl, in which $1 and ${name}
+// stand for submatches as in regexp.Expand. All replacements are made as a
+// single edit, so one undo reverts them together. It returns the number of
+// matches replaced.
+func (b *TextBuffer) ReplaceAll(re *regexp.Regexp, repl string) int {
+	return b.replaceAll(re, func(dst []byte, text string, loc []int) []byte {
+		return re.ExpandString(dst, repl, text, loc)
+	})
+}
+
+// ReplaceAllLiteral is like ReplaceAll but inserts repl as is.
+func (b *TextBuffer) ReplaceAllLiteral(re *regexp.Regexp, repl string) int {
+	return b.replaceAll(re, func(dst []byte, _ string, _ []int) []byte {
+		return append(dst, repl...)
+	})
+}
+
+func (b *TextBuffer) replaceAll(re *regexp.Regexp, expand func(dst []byte, text string, loc []int) []byte) int {
+	text := b.Text()
+	locs := re.FindAllStringSubmatchIndex(text, -1)
+	if len(locs) == 0 {
+		return 0
+	}
+	first, last := locs[0][0], locs[len(locs)-1][1]
+	var out []byte
+	prev := first
+	for _, loc := range locs {
+		out = append(out, text[prev:loc[0]]...)
+		out = expand(out, text, loc)
+		prev = loc[1]
+	}
+	b.ReplaceRange(first, last, string(out))
+	return len(locs)
+}
@@ -0,0 +1,124 @@
+package fltk_bridge
+
+import (
+	"regexp"
+	"testing"
+)
+
+// searchText has "foo" at 1, 5, 9 and 16; the first and the last are inside
+// words and only the third starts a line.
+const searchText = "xfoo foo\nfoo barfoo"
+
+func TestFindRegexpAnchorsAndWordBoundaries(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		from       int
+		start, end int
+		found      bool
+	}{
+		{`(?m)^foo`, 1, 9, 12, true},
+		{`^foo`, 0, 0, 0, false},
+		{`\bfoo\b`, 1, 5, 8, true},
+		{`\bfoo\b`, 6, 9, 12, true},
+		{`\bfoo\b`, 10, 0, 0, false},
+		{`foo`, 6, 9, 12, true},
+		{`foo$`, 0, 16, 19, true},
+	}
+	for _, tt := range tests {
+		start, end, found := findRegexp(regexp.MustCompile(tt.pattern), searchText, tt.from)
+		if start != tt.start || end != tt.end || found != tt.found {
+			t.Errorf("findRegexp(%q, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.pattern, tt.from, start, end, found, tt.start, tt.end, tt.found)
+		}
+	}
+}
+
+func TestFindRegexpBackwardAnchorsAndWordBoundaries(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		pos        int
+		start, end int
+		found      bool
+	}{
+		{`(?m)foo$`, 4, 0, 0, false},
+		{`(?m)foo$`, 8, 5, 8, true},
+		{`bar\b`, 16, 0, 0, false},
+		{`\bfoo\b`, 19, 9, 12, true},
+		{`\bfoo`, 11, 5, 8, true},
+		{`foo`, 19, 16, 19, true},
+	}
+	for _, tt := range tests {
+		start, end, found := findRegexpBackward(regexp.MustCompile(tt.pattern), searchText, tt.pos)
+		if start != tt.start || end != tt.end || found != tt.found {
+			t.Errorf("findRegexpBackward(%q, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.pattern, tt.pos, start, end, found, tt.start, tt.end, tt.found)
+		}
+	}
+}
+
+func TestSubmatchAt(t *testing.T) {
+	re := regexp.MustCompile(`(?m)^(f)oo`)
+	if loc := submatchAt(re, searchText, 9, 12); len(loc) != 4 || loc[2] != 9 || loc[3] != 10 {
+		t.Errorf("submatchAt(9, 12) = %v, want [9 12 9 10]", loc)
+	}
+	if loc := submatchAt(re, searchText, 1, 4); loc != nil {
+		t.Errorf("submatchAt(1, 4) = %v, want nil as foo does not start a line there", loc)
+	}
+}
+
+func TestFindRegexpOverlappingMatches(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		from          int
+		start, end    int
+		found         bool
+	}{
+		{`aa`, "aaa", 0, 0, 2, true},
+		{`aa`, "aaa", 1, 1, 3, true},
+		{`aa`, "aaa", 2, 0, 0, false},
+		{`aba`, "ababa", 1, 2, 5, true},
+		{`\Boo`, "xfoo", 1, 2, 4, true},
+		{`\bb`, "ab b", 1, 3, 4, true},
+		{`x*`, "ab", 1, 1, 1, true},
+		{`$`, "ab", 2, 2, 2, true},
+	}
+	for _, tt := range tests {
+		start, end, found := findRegexp(regexp.MustCompile(tt.pattern), tt.text, tt.from)
+		if start != tt.start || end != tt.end || found != tt.found {
+			t.Errorf("findRegexp(%q, %q, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.pattern, tt.text, tt.from, start, end, found, tt.start, tt.end, tt.found)
+		}
+	}
+}
+
+func TestFindRegexpBackwardOverlappingMatches(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		pos           int
+		start, end    int
+		found         bool
+	}{
+		{`aa`, "aaa", 3, 1, 3, true},
+		{`aa`, "aaa", 2, 0, 2, true},
+		{`aa`, "aaa", 1, 0, 0, false},
+		{`aba`, "ababa", 5, 2, 5, true},
+		{`aba`, "ababa", 4, 0, 3, true},
+	}
+	for _, tt := range tests {
+		start, end, found := findRegexpBackward(regexp.MustCompile(tt.pattern), tt.text, tt.pos)
+		if start != tt.start || end != tt.end || found != tt.found {
+			t.Errorf("findRegexpBackward(%q, %q, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.pattern, tt.text, tt.pos, start, end, found, tt.start, tt.end, tt.found)
+		}
+	}
+}
+
+func TestSubmatchAtOverlappingMatch(t *testing.T) {
+	re := regexp.MustCompile(`a(a)`)
+	if loc := submatchAt(re, "aaa", 1, 3); len(loc) != 4 || loc[0] != 1 || loc[1] != 3 || loc[2] != 2 || loc[3] != 3 {
+		t.Errorf("submatchAt(1, 3) = %v, want [1 3 2 3]", loc)
+	}
+	if loc := submatchAt(re, "aaa", 1, 2); loc != nil {
+		t.Errorf("submatchAt(1, 2) = %v, want nil", loc)
+	}
+}
@@ -35,10 +35,294 @@ void go_fltk_Tree_clear_children(Fl_Tree *tree, Fl_Tree_Item *item) {
   tree->clear_children(item);
 }  
 
+Fl_Tree_Item *go_fltk_Tree_add_child(Fl_Tree *tree, Fl_Tree_Item *parent, const char *name) {
+  return tree->add(parent, name);
+}
+
+Fl_Tree_Item *go_fltk_Tree_insert(Fl_Tree *tree, Fl_Tree_Item *parent, const char *name, int pos) {
+  return tree->insert(parent, name, pos);
+}
+
+Fl_Tree_Item *go_fltk_Tree_insert_above(Fl_Tree *tree, Fl_Tree_Item *above, const char *name) {
+  return tree->insert_above(above, name);
+}
+
+Fl_Tree_Item *go_fltk_Tree_root(Fl_Tree *tree) {
+  return tree->root();
+}
+
+Fl_Tree_Item *go_fltk_Tree_first(Fl_Tree *tree) {
+  return tree->first();
+}
+
+Fl_Tree_Item *go_fltk_Tree_last(Fl_Tree *tree) {
+  return tree->last();
+}
+
+Fl_Tree_Item *go_fltk_Tree_next(Fl_Tree *tree, Fl_Tree_Item *item) {
+  return tree->next(item);
+}
+
+Fl_Tree_Item *go_fltk_Tree_prev(Fl_Tree *tree, Fl_Tree_Item *item) {
+  return tree->prev(item);
+}
+
+Fl_Tree_Item *go_fltk_Tree_first_selected_item(Fl_Tree *tree) {
+  return tree->first_selected_item();
+}
+
+Fl_Tree_Item *go_fltk_Tree_last_selected_item(Fl_Tree *tree) {
+  return tree->last_selected_item();
+}
+
+Fl_Tree_Item *go_fltk_Tree_next_selected_item(Fl_Tree *tree, Fl_Tree_Item *item) {
+  return tree->next_selected_item(item);
+}
+
+Fl_Tree_Item *go_fltk_Tree_find_item(Fl_Tree *tree, const char *path) {
+  return tree->find_item(path);
+}
+
+int go_fltk_Tree_item_pathname(Fl_Tree *tree, char *pathname, int len, Fl_Tree_Item *item) {
+  return tree->item_pathname(pathname, len, item);
+}
+
+Fl_Tree_Item *go_fltk_Tree_item_clicked(Fl_Tree *tree) {
+  return tree->item_clicked();
+}
+
+int go_fltk_Tree_open(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->open(item, docallback);
+}
+
+int go_fltk_Tree_close(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->close(item, docallback);
+}
+
+int go_fltk_Tree_select(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->select(item, docallback);
+}
+
+int go_fltk_Tree_deselect(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->deselect(item, docallback);
+}
+
+int go_fltk_Tree_select_only(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->select_only(item, docallback);
+}
+
+int go_fltk_Tree_select_all(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->select_all(item, docallback);
+}
+
+int go_fltk_Tree_deselect_all(Fl_Tree *tree, Fl_Tree_Item *item, int docallback) {
+  return tree->deselect_all(item, docallback);
+}
+
+void go_fltk_Tree_set_item_focus(Fl_Tree *tree, Fl_Tree_Item *item) {
+  tree->set_item_focus(item);
+}
+
+Fl_Tree_Item *go_fltk_Tree_get_item_focus(Fl_Tree *tree) {
+  return tree->get_item_focus();
+}
+
+int go_fltk_Tree_displayed(Fl_Tree *tree, Fl_Tree_Item *item) {
+  return tree->displayed(item);
+}
+
+void go_fltk_Tree_show_item(Fl_Tree *tree, Fl_Tree_Item *item) {
+  tree->show_item(item);
+}
+
+void go_fltk_Tree_show_item_top(Fl_Tree *tree, Fl_Tree_Item *item) {
+  tree->show_item_top(item);
+}
+
+void go_fltk_Tree_show_item_middle(Fl_Tree *tree, Fl_Tree_Item *item) {
+  tree->show_item_middle(item);
+}
+
+void go_fltk_Tree_show_item_bottom(Fl_Tree *tree, Fl_Tree_Item *item) {
+  tree->show_item_bottom(item);
+}
+
+int go_fltk_Tree_item_labelfont(Fl_Tree *tree) {
+  return tree->item_labelfont();
+}
+
+void go_fltk_Tree_set_item_labelfont(Fl_Tree *tree, int font) {
+  tree->item_labelfont(font);
+}
+
+int go_fltk_Tree_item_labelsize(Fl_Tree *tree) {
+  return tree->item_labelsize();
+}
+
+void go_fltk_Tree_set_item_labelsize(Fl_Tree *tree, int size) {
+  tree->item_labelsize(size);
+}
+
+unsigned int go_fltk_Tree_item_labelfgcolor(Fl_Tree *tree) {
+  return tree->item_labelfgcolor();
+}
+
+void go_fltk_Tree_set_item_labelfgcolor(Fl_Tree *tree, unsigned int color) {
+  tree->item_labelfgcolor(color);
+}
+
+unsigned int go_fltk_Tree_item_labelbgcolor(Fl_Tree *tree) {
+  return tree->item_labelbgcolor();
+}
+
+void go_fltk_Tree_set_item_labelbgcolor(Fl_Tree *tree, unsigned int color) {
+  tree->item_labelbgcolor(color);
+}
+
+void go_fltk_Tree_set_connectorcolor(Fl_Tree *tree, unsigned int color) {
+  tree->connectorcolor(color);
+}
+
+void go_fltk_Tree_set_showcollapse(Fl_Tree *tree, int show) {
+  tree->showcollapse(show);
+}
+
+void go_fltk_Tree_set_root_label(Fl_Tree *tree, const char *label) {
+  tree->root_label(label);
+}
+
+Fl_Tree_Item *go_fltk_Tree_callback_item(Fl_Tree *tree) {
+  return tree->callback_item();
+}
+
+int go_fltk_Tree_callback_reason(Fl_Tree *tree) {
+  return (int)tree->callback_reason();
+}
+
 void go_fltk_Tree_Item_set_widget(Fl_Tree_Item *item, Fl_Widget *widget) {
   item->widget(widget);
 }
 
+const char *go_fltk_Tree_Item_label(Fl_Tree_Item *item) {
+  return item->label();
+}
+
+void go_fltk_Tree_Item_set_label(Fl_Tree_Item *item, const char *label) {
+  item->label(label);
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_parent(Fl_Tree_Item *item) {
+  return item->parent();
+}
+
+int go_fltk_Tree_Item_children(Fl_Tree_Item *item) {
+  return item->children();
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_child(Fl_Tree_Item *item, int index) {
+  return item->child(index);
+}
+
+int go_fltk_Tree_Item_find_child(Fl_Tree_Item *item, const char *name) {
+  return item->find_child(name);
+}
+
+int go_fltk_Tree_Item_index_of(Fl_Tree_Item *item, Fl_Tree_Item *child) {
+  return item->find_child(child);
+}
+
+int go_fltk_Tree_Item_depth(Fl_Tree_Item *item) {
+  return item->depth();
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_next(Fl_Tree_Item *item) {
+  return item->next();
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_prev(Fl_Tree_Item *item) {
+  return item->prev();
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_next_sibling(Fl_Tree_Item *item) {
+  return item->next_sibling();
+}
+
+Fl_Tree_Item *go_fltk_Tree_Item_prev_sibling(Fl_Tree_Item *item) {
+  return item->prev_sibling();
+}
+
+int go_fltk_Tree_Item_is_root(Fl_Tree_Item *item) {
+  return item->is_root();
+}
+
+int go_fltk_Tree_Item_is_open(Fl_Tree_Item *item) {
+  return item->is_open();
+}
+
+int go_fltk_Tree_Item_is_selected(Fl_Tree_Item *item) {
+  return item->is_selected();
+}
+
+int go_fltk_Tree_Item_is_active(Fl_Tree_Item *item) {
+  return item->is_active();
+}
+
+void go_fltk_Tree_Item_activate(Fl_Tree_Item *item, int active) {
+  item->activate(active);
+}
+
+void go_fltk_Tree_Item_set_usericon(Fl_Tree_Item *item, Fl_Image *image) {
+  item->usericon(image);
+}
+
+int go_fltk_Tree_Item_labelfont(Fl_Tree_Item *item) {
+  return item->labelfont();
+}
+
+void go_fltk_Tree_Item_set_labelfont(Fl_Tree_Item *item, int font) {
+  item->labelfont(font);
+}
+
+int go_fltk_Tree_Item_labelsize(Fl_Tree_Item *item) {
+  return item->labelsize();
+}
+
+void go_fltk_Tree_Item_set_labelsize(Fl_Tree_Item *item, int size) {
+  item->labelsize(size);
+}
+
+unsigned int go_fltk_Tree_Item_labelfgcolor(Fl_Tree_Item *item) {
+  return item->labelfgcolor();
+}
+
+void go_fltk_Tree_Item_set_labelfgcolor(Fl_Tree_Item *item, unsigned int color) {
+  item->labelfgcolor(color);
+}
+
+unsigned int go_fltk_Tree_Item_labelbgcolor(Fl_Tree_Item *item) {
+  return item->labelbgcolor();
+}
+
+void go_fltk_Tree_Item_set_labelbgcolor(Fl_Tree_Item *item, unsigned int color) {
+  item->labelbgcolor(color);
+}
+
+int go_fltk_Tree_Item_move_above(Fl_Tree_Item *item, Fl_Tree_Item *other) {
+  return item->move_above(other);
+}
+
+int go_fltk_Tree_Item_move_below(Fl_Tree_Item *item, Fl_Tree_Item *other) {
+  return item->move_below(other);
+}
+
+int go_fltk_Tree_Item_move_into(Fl_Tree_Item *item, Fl_Tree_Item *parent, int pos) {
+  return item->move_into(parent, pos);
+}
+
+int go_fltk_Tree_Item_move(Fl_Tree_Item *item, int to, int from) {
+  return item->move(to, from);
+}
+
 const unsigned int go_FL_TREE_ITEM_DRAW_DEFAULT = (unsigned int)FL_TREE_ITEM_DRAW_DEFAULT;
 const unsigned int go_FL_TREE_ITEM_DRAW_LABEL_AND_WIDGET = (unsigned int)FL_TREE_ITEM_DRAW_LABEL_AND_WIDGET;
 const unsigned int go_FL_TREE_ITEM_HEIGHT_FROM_WIDGET = (unsigned int)FL_TREE_ITEM_HEIGHT_FROM_WIDGET;
@@ -62,3 +346,18 @@ const int go_FL_TREE_SELECT_SINGLE_DRAGGABLE = (int)FL_TREE_SELECT_SINGLE_DRAGGA
 void go_fltk_Tree_set_select_mode(Fl_Tree *tree, int selectMode) {
   tree->selectmode((Fl_Tree_Select)selectMode);
 }  
+
+const int go_FL_TREE_SELECTABLE_ONCE = (int)FL_TREE_SELECTABLE_ONCE;
+const int go_FL_TREE_SELECTABLE_ALWAYS = (int)FL_TREE_SELECTABLE_ALWAYS;
+
+void go_fltk_Tree_set_item_reselect_mode(Fl_Tree *tree, int mode) {
+  tree->item_reselect_mode((Fl_Tree_Item_Reselect_Mode)mode);
+}
+
+const int go_FL_TREE_REASON_NONE = (int)FL_TREE_REASON_NONE;
+const int go_FL_TREE_REASON_SELECTED = (int)FL_TREE_REASON_SELECTED;
+const int go_FL_TREE_REASON_DESELECTED = (int)FL_TREE_REASON_DESELECTED;
+const int go_FL_TREE_REASON_RESELECTED = (int)FL_TREE_REASON_RESELECTED;
+const int go_FL_TREE_REASON_OPENED = (int)FL_TREE_REASON_OPENED;
+const int go_FL_TREE_REASON_CLOSED = (int)FL_TREE_REASON_CLOSED;
+const int go_FL_TREE_REASON_DRAGGED = (int)FL_TREE_REASON_DRAGGED;
@@ -9,6 +9,12 @@ import "unsafe"
 
 type Tree struct {
 	Group
+	// data holds the values set with TreeItem.SetUserData.
+	data map[*C.Fl_Tree_Item]any
+	// icons maps items to their icons and iconUses counts the items showing
+	// each icon, which the tree keeps alive.
+	icons    map[*C.Fl_Tree_Item]*image
+	iconUses map[*image]int
 }
 
 func NewTree(x, y, w, h int, text ...string) *Tree {
@@ -25,30 +31,511 @@ func (t *Tree) SetShowRoot(show bool) {
 	}
 }
 
+// SetRootLabel sets the label of the root item.
+func (t *Tree) SetRootLabel(label string) {
+	labelStr := C.CString(label)
+	defer C.free(unsafe.Pointer(labelStr))
+	C.go_fltk_Tree_set_root_label((*C.Fl_Tree)(t.ptr()), labelStr)
+}
+
+// SetShowCollapse sets whether items with children show an open/close
+// icon.
+func (t *Tree) SetShowCollapse(show bool) {
+	C.go_fltk_Tree_set_showcollapse((*C.Fl_Tree)(t.ptr()), cBool(show))
+}
+
+// TreeItem is an item of a Tree. The zero TreeItem stands for no item and
+// is what lookups and traversal return when there is none; see IsValid. A
+// TreeItem must not be used after it has been removed from its tree.
 type TreeItem struct {
-	ptr *C.Fl_Tree_Item
+	ptr  *C.Fl_Tree_Item
+	tree *Tree
+}
+
+func (t *Tree) item(ptr *C.Fl_Tree_Item) TreeItem {
+	if ptr == nil {
+		return TreeItem{}
+	}
+	return TreeItem{ptr: ptr, tree: t}
 }
 
+// IsValid reports whether t refers to an item.
+func (t TreeItem) IsValid() bool {
+	return t.ptr != nil
+}
+
+// Add adds an item for path, such as "Fruit/Apple", creating the missing
+// items along it, and returns the last one.
 func (t *Tree) Add(path string) TreeItem {
 	pathStr := C.CString(path)
 	defer C.free(unsafe.Pointer(pathStr))
 	itemPtr := C.go_fltk_Tree_add((*C.Fl_Tree)(t.ptr()), pathStr)
-	return TreeItem{ptr: itemPtr}
+	return t.item(itemPtr)
+}
+
+// AddChild adds an item named name as the last child of parent. Slashes
+// in name are not path separators.
+func (t *Tree) AddChild(parent TreeItem, name string) TreeItem {
+	nameStr := C.CString(name)
+	defer C.free(unsafe.Pointer(nameStr))
+	return t.item(C.go_fltk_Tree_add_child((*C.Fl_Tree)(t.ptr()), parent.ptr, nameStr))
+}
+
+// Insert adds an item named name as the child of parent at index pos.
+func (t *Tree) Insert(parent TreeItem, name string, pos int) TreeItem {
+	nameStr := C.CString(name)
+	defer C.free(unsafe.Pointer(nameStr))
+	return t.item(C.go_fltk_Tree_insert((*C.Fl_Tree)(t.ptr()), parent.ptr, nameStr, C.int(pos)))
+}
+
+// InsertAbove adds an item named name as the sibling just above above.
+func (t *Tree) InsertAbove(above TreeItem, name string) TreeItem {
+	nameStr := C.CString(name)
+	defer C.free(unsafe.Pointer(nameStr))
+	return t.item(C.go_fltk_Tree_insert_above((*C.Fl_Tree)(t.ptr()), above.ptr, nameStr))
 }
+
+// Remove deletes item and its children.
 func (t *Tree) Remove(item TreeItem) bool {
+	t.forgetItems(item, true)
 	return C.go_fltk_Tree_remove((*C.Fl_Tree)(t.ptr()), item.ptr) == 0
 }
 func (t *Tree) Clear() {
+	t.data = nil
+	t.icons, t.iconUses = nil, nil
+	t.setImages(roleIcons)
 	C.go_fltk_Tree_clear((*C.Fl_Tree)(t.ptr()))
 }
 func (t *Tree) ClearChildren(item TreeItem) {
+	t.forgetItems(item, false)
 	C.go_fltk_Tree_clear_children((*C.Fl_Tree)(t.ptr()), item.ptr)
 }
 
+// forgetItems drops the user data of the descendants of item, and of item
+// itself if self is set, and releases their icons.
+func (t *Tree) forgetItems(item TreeItem, self bool) {
+	if (len(t.data) == 0 && len(t.icons) == 0) || !item.IsValid() {
+		return
+	}
+	if self {
+		delete(t.data, item.ptr)
+		t.setItemIcon(item.ptr, nil)
+	}
+	for i := 0; i < item.Children(); i++ {
+		t.forgetItems(item.Child(i), true)
+	}
+}
+
+// Root returns the root item, or the zero TreeItem after Clear.
+func (t *Tree) Root() TreeItem {
+	return t.item(C.go_fltk_Tree_root((*C.Fl_Tree)(t.ptr())))
+}
+
+// First and Last return the first and the last item of the tree in
+// top-down order, open or not.
+func (t *Tree) First() TreeItem {
+	return t.item(C.go_fltk_Tree_first((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) Last() TreeItem {
+	return t.item(C.go_fltk_Tree_last((*C.Fl_Tree)(t.ptr())))
+}
+
+// Next and Prev return the item after and before item in top-down order,
+// open or not.
+func (t *Tree) Next(item TreeItem) TreeItem {
+	return t.item(C.go_fltk_Tree_next((*C.Fl_Tree)(t.ptr()), item.ptr))
+}
+func (t *Tree) Prev(item TreeItem) TreeItem {
+	return t.item(C.go_fltk_Tree_prev((*C.Fl_Tree)(t.ptr()), item.ptr))
+}
+
+// FindItem returns the item at path, or the zero TreeItem if there is none.
+func (t *Tree) FindItem(path string) TreeItem {
+	pathStr := C.CString(path)
+	defer C.free(unsafe.Pointer(pathStr))
+	return t.item(C.go_fltk_Tree_find_item((*C.Fl_Tree)(t.ptr()), pathStr))
+}
+
+// ItemPathname returns the path of item as Add and FindItem take it, with
+// slashes and backslashes in labels escaped.
+func (t *Tree) ItemPathname(item TreeItem) string {
+	for size := 1024; ; size *= 4 {
+		buf := (*C.char)(C.malloc(C.size_t(size)))
+		ret := C.go_fltk_Tree_item_pathname((*C.Fl_Tree)(t.ptr()), buf, C.int(size), item.ptr)
+		path := C.GoString(buf)
+		C.free(unsafe.Pointer(buf))
+		switch ret {
+		case 0:
+			return path
+		case -2:
+			continue
+		}
+		return ""
+	}
+}
+
+// ItemClicked returns the item that was last clicked, or the zero
+// TreeItem.
+func (t *Tree) ItemClicked() TreeItem {
+	return t.item(C.go_fltk_Tree_item_clicked((*C.Fl_Tree)(t.ptr())))
+}
+
+// Open opens item, showing its children, and reports whether it was
+// closed. The callback is run with TreeReasonOpened if doCallback is set
+// and the item changed.
+func (t *Tree) Open(item TreeItem, doCallback bool) bool {
+	return C.go_fltk_Tree_open((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)) == 1
+}
+
+// Close closes item, hiding its children, and reports whether it was open.
+// The callback is run with TreeReasonClosed if doCallback is set and the
+// item changed.
+func (t *Tree) Close(item TreeItem, doCallback bool) bool {
+	return C.go_fltk_Tree_close((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)) == 1
+}
+
+// Select selects item and reports whether it was not selected before.
+func (t *Tree) Select(item TreeItem, doCallback bool) bool {
+	return C.go_fltk_Tree_select((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)) == 1
+}
+
+// Deselect deselects item and reports whether it was selected before.
+func (t *Tree) Deselect(item TreeItem, doCallback bool) bool {
+	return C.go_fltk_Tree_deselect((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)) == 1
+}
+
+// SelectOnly selects item and deselects all others. It returns the number
+// of items that changed.
+func (t *Tree) SelectOnly(item TreeItem, doCallback bool) int {
+	return int(C.go_fltk_Tree_select_only((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)))
+}
+
+// SelectAll selects item and all its descendants, or every item if item is
+// the zero TreeItem, and returns the number of items that changed.
+func (t *Tree) SelectAll(item TreeItem, doCallback bool) int {
+	return int(C.go_fltk_Tree_select_all((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)))
+}
+
+// DeselectAll deselects item and all its descendants, or every item if item
+// is the zero TreeItem, and returns the number of items that changed.
+func (t *Tree) DeselectAll(item TreeItem, doCallback bool) int {
+	return int(C.go_fltk_Tree_deselect_all((*C.Fl_Tree)(t.ptr()), item.ptr, cBool(doCallback)))
+}
+
+// FirstSelectedItem and LastSelectedItem return the first and the last
+// selected item in top-down order, or the zero TreeItem.
+func (t *Tree) FirstSelectedItem() TreeItem {
+	return t.item(C.go_fltk_Tree_first_selected_item((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) LastSelectedItem() TreeItem {
+	return t.item(C.go_fltk_Tree_last_selected_item((*C.Fl_Tree)(t.ptr())))
+}
+
+// NextSelectedItem returns the first selected item below item.
+func (t *Tree) NextSelectedItem(item TreeItem) TreeItem {
+	return t.item(C.go_fltk_Tree_next_selected_item((*C.Fl_Tree)(t.ptr()), item.ptr))
+}
+
+// SelectedItems returns the selected items in top-down order.
+func (t *Tree) SelectedItems() []TreeItem {
+	var items []TreeItem
+	for item := t.FirstSelectedItem(); item.IsValid(); item = t.NextSelectedItem(item) {
+		items = append(items, item)
+	}
+	return items
+}
+
+// SetItemFocus moves the keyboard focus box to item.
+func (t *Tree) SetItemFocus(item TreeItem) {
+	C.go_fltk_Tree_set_item_focus((*C.Fl_Tree)(t.ptr()), item.ptr)
+}
+func (t *Tree) ItemFocus() TreeItem {
+	return t.item(C.go_fltk_Tree_get_item_focus((*C.Fl_Tree)(t.ptr())))
+}
+
+// Displayed reports whether item is at least partly scrolled into view. It
+// does not check whether its parents are open.
+func (t *Tree) Displayed(item TreeItem) bool {
+	return C.go_fltk_Tree_displayed((*C.Fl_Tree)(t.ptr()), item.ptr) != 0
+}
+
+// ShowItem scrolls item into view if it is not, and ShowItemTop,
+// ShowItemMiddle and ShowItemBottom scroll it to the top, middle or bottom
+// of the view.
+func (t *Tree) ShowItem(item TreeItem) {
+	C.go_fltk_Tree_show_item((*C.Fl_Tree)(t.ptr()), item.ptr)
+}
+func (t *Tree) ShowItemTop(item TreeItem) {
+	C.go_fltk_Tree_show_item_top((*C.Fl_Tree)(t.ptr()), item.ptr)
+}
+func (t *Tree) ShowItemMiddle(item TreeItem) {
+	C.go_fltk_Tree_show_item_middle((*C.Fl_Tree)(t.ptr()), item.ptr)
+}
+func (t *Tree) ShowItemBottom(item TreeItem) {
+	C.go_fltk_Tree_show_item_bottom((*C.Fl_Tree)(t.ptr()), item.ptr)
+}
+
+// ItemLabelFont, ItemLabelSize, ItemLabelFgColor and ItemLabelBgColor are
+// the defaults for items added from then on.
+func (t *Tree) ItemLabelFont() Font {
+	return Font(C.go_fltk_Tree_item_labelfont((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) SetItemLabelFont(font Font) {
+	C.go_fltk_Tree_set_item_labelfont((*C.Fl_Tree)(t.ptr()), C.int(font))
+}
+func (t *Tree) ItemLabelSize() int {
+	return int(C.go_fltk_Tree_item_labelsize((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) SetItemLabelSize(size int) {
+	C.go_fltk_Tree_set_item_labelsize((*C.Fl_Tree)(t.ptr()), C.int(size))
+}
+func (t *Tree) ItemLabelFgColor() Color {
+	return Color(C.go_fltk_Tree_item_labelfgcolor((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) SetItemLabelFgColor(color Color) {
+	C.go_fltk_Tree_set_item_labelfgcolor((*C.Fl_Tree)(t.ptr()), C.uint(color))
+}
+func (t *Tree) ItemLabelBgColor() Color {
+	return Color(C.go_fltk_Tree_item_labelbgcolor((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) SetItemLabelBgColor(color Color) {
+	C.go_fltk_Tree_set_item_labelbgcolor((*C.Fl_Tree)(t.ptr()), C.uint(color))
+}
+
+func (t *Tree) SetConnectorColor(color Color) {
+	C.go_fltk_Tree_set_connectorcolor((*C.Fl_Tree)(t.ptr()), C.uint(color))
+}
+
+// TreeReason tells why the callback of a Tree was run.
+type TreeReason int
+
+var (
+	TreeReasonNone       = TreeReason(C.go_FL_TREE_REASON_NONE)
+	TreeReasonSelected   = TreeReason(C.go_FL_TREE_REASON_SELECTED)
+	TreeReasonDeselected = TreeReason(C.go_FL_TREE_REASON_DESELECTED)
+	TreeReasonReselected = TreeReason(C.go_FL_TREE_REASON_RESELECTED)
+	TreeReasonOpened     = TreeReason(C.go_FL_TREE_REASON_OPENED)
+	TreeReasonClosed     = TreeReason(C.go_FL_TREE_REASON_CLOSED)
+	TreeReasonDragged    = TreeReason(C.go_FL_TREE_REASON_DRAGGED)
+)
+
+// CallbackItem and CallbackReason return the item and the reason of the
+// running callback.
+func (t *Tree) CallbackItem() TreeItem {
+	return t.item(C.go_fltk_Tree_callback_item((*C.Fl_Tree)(t.ptr())))
+}
+func (t *Tree) CallbackReason() TreeReason {
+	return TreeReason(C.go_fltk_Tree_callback_reason((*C.Fl_Tree)(t.ptr())))
+}
+
+// SetItemCallback sets the callback of the tree to handler, which is passed
+// the item that was selected, deselected, reselected, opened, closed or
+// dragged and why. It replaces a callback set with SetCallback.
+func (t *Tree) SetItemCallback(handler func(item TreeItem, reason TreeReason)) {
+	t.SetCallback(func() {
+		handler(t.CallbackItem(), t.CallbackReason())
+	})
+}
+
 func (t TreeItem) SetWidget(w Widget) {
 	C.go_fltk_Tree_Item_set_widget(t.ptr, w.getWidget().ptr())
 }
 
+func (t TreeItem) Label() string {
+	return C.GoString(C.go_fltk_Tree_Item_label(t.ptr))
+}
+func (t TreeItem) SetLabel(label string) {
+	labelStr := C.CString(label)
+	defer C.free(unsafe.Pointer(labelStr))
+	C.go_fltk_Tree_Item_set_label(t.ptr, labelStr)
+	t.tree.Redraw()
+}
+
+// Parent returns the parent of the item, or the zero TreeItem for the root.
+func (t TreeItem) Parent() TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_parent(t.ptr))
+}
+
+// Children returns the number of children of the item.
+func (t TreeItem) Children() int {
+	return int(C.go_fltk_Tree_Item_children(t.ptr))
+}
+func (t TreeItem) HasChildren() bool {
+	return t.Children() > 0
+}
+func (t TreeItem) Child(index int) TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_child(t.ptr, C.int(index)))
+}
+
+// FindChild returns the index of the first child labeled name, or -1.
+func (t TreeItem) FindChild(name string) int {
+	nameStr := C.CString(name)
+	defer C.free(unsafe.Pointer(nameStr))
+	return int(C.go_fltk_Tree_Item_find_child(t.ptr, nameStr))
+}
+
+// IndexOf returns the index of child among the children of the item, or -1.
+func (t TreeItem) IndexOf(child TreeItem) int {
+	return int(C.go_fltk_Tree_Item_index_of(t.ptr, child.ptr))
+}
+
+// Depth returns the number of parents of the item; it is 0 for the root.
+func (t TreeItem) Depth() int {
+	return int(C.go_fltk_Tree_Item_depth(t.ptr))
+}
+
+// Next and Prev return the item after and before this one in top-down
+// order, open or not.
+func (t TreeItem) Next() TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_next(t.ptr))
+}
+func (t TreeItem) Prev() TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_prev(t.ptr))
+}
+func (t TreeItem) NextSibling() TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_next_sibling(t.ptr))
+}
+func (t TreeItem) PrevSibling() TreeItem {
+	return t.tree.item(C.go_fltk_Tree_Item_prev_sibling(t.ptr))
+}
+
+func (t TreeItem) IsRoot() bool {
+	return C.go_fltk_Tree_Item_is_root(t.ptr) != 0
+}
+func (t TreeItem) IsOpen() bool {
+	return C.go_fltk_Tree_Item_is_open(t.ptr) != 0
+}
+func (t TreeItem) IsSelected() bool {
+	return C.go_fltk_Tree_Item_is_selected(t.ptr) != 0
+}
+func (t TreeItem) IsActive() bool {
+	return C.go_fltk_Tree_Item_is_active(t.ptr) != 0
+}
+
+// SetActive activates or deactivates the item. Inactive items are grayed
+// out and cannot be selected by the user.
+func (t TreeItem) SetActive(active bool) {
+	C.go_fltk_Tree_Item_activate(t.ptr, cBool(active))
+	t.tree.Redraw()
+}
+
+// SetUserIcon sets the icon drawn left of the label. The tree keeps the
+// image alive while the item shows it.
+func (t TreeItem) SetUserIcon(img Image) {
+	icon := img.getImage()
+	C.go_fltk_Tree_Item_set_usericon(t.ptr, icon.ptr())
+	t.tree.setItemIcon(t.ptr, icon)
+	t.tree.Redraw()
+}
+
+// ClearUserIcon removes the icon of the item.
+func (t TreeItem) ClearUserIcon() {
+	C.go_fltk_Tree_Item_set_usericon(t.ptr, nil)
+	t.tree.setItemIcon(t.ptr, nil)
+	t.tree.Redraw()
+}
+
+// setItemIcon records that item shows icon, or no icon if it is nil, and
+// keeps alive the icons shown by at least one item.
+func (t *Tree) setItemIcon(item *C.Fl_Tree_Item, icon *image) {
+	old := t.icons[item]
+	if old == icon {
+		return
+	}
+	changed := false
+	if icon != nil {
+		if t.icons == nil {
+			t.icons = make(map[*C.Fl_Tree_Item]*image)
+			t.iconUses = make(map[*image]int)
+		}
+		t.icons[item] = icon
+		t.iconUses[icon]++
+		changed = t.iconUses[icon] == 1
+	} else {
+		delete(t.icons, item)
+	}
+	if old != nil {
+		if t.iconUses[old]--; t.iconUses[old] == 0 {
+			delete(t.iconUses, old)
+			changed = true
+		}
+	}
+	if changed {
+		icons := make([]*image, 0, len(t.iconUses))
+		for icon := range t.iconUses {
+			icons = append(icons, icon)
+		}
+		t.setImages(roleIcons, icons...)
+	}
+}
+
+func (t TreeItem) LabelFont() Font {
+	return Font(C.go_fltk_Tree_Item_labelfont(t.ptr))
+}
+func (t TreeItem) SetLabelFont(font Font) {
+	C.go_fltk_Tree_Item_set_labelfont(t.ptr, C.int(font))
+	t.tree.Redraw()
+}
+func (t TreeItem) LabelSize() int {
+	return int(C.go_fltk_Tree_Item_labelsize(t.ptr))
+}
+func (t TreeItem) SetLabelSize(size int) {
+	C.go_fltk_Tree_Item_set_labelsize(t.ptr, C.int(size))
+	t.tree.Redraw()
+}
+func (t TreeItem) LabelFgColor() Color {
+	return Color(C.go_fltk_Tree_Item_labelfgcolor(t.ptr))
+}
+func (t TreeItem) SetLabelFgColor(color Color) {
+	C.go_fltk_Tree_Item_set_labelfgcolor(t.ptr, C.uint(color))
+	t.tree.Redraw()
+}
+func (t TreeItem) LabelBgColor() Color {
+	return Color(C.go_fltk_Tree_Item_labelbgcolor(t.ptr))
+}
+func (t TreeItem) SetLabelBgColor(color Color) {
+	C.go_fltk_Tree_Item_set_labelbgcolor(t.ptr, C.uint(color))
+	t.tree.Redraw()
+}
+
+// UserData returns the value set with SetUserData, or nil.
+func (t TreeItem) UserData() any {
+	return t.tree.data[t.ptr]
+}
+
+// SetUserData attaches data to the item. It is dropped when the item is
+// removed through its tree.
+func (t TreeItem) SetUserData(data any) {
+	if t.tree.data == nil {
+		t.tree.data = make(map[*C.Fl_Tree_Item]any)
+	}
+	t.tree.data[t.ptr] = data
+}
+
+// MoveAbove and MoveBelow move the item, with its children, to be the
+// sibling just above or below other, which may have another parent.
+func (t TreeItem) MoveAbove(other TreeItem) bool {
+	defer t.tree.Redraw()
+	return C.go_fltk_Tree_Item_move_above(t.ptr, other.ptr) == 0
+}
+func (t TreeItem) MoveBelow(other TreeItem) bool {
+	defer t.tree.Redraw()
+	return C.go_fltk_Tree_Item_move_below(t.ptr, other.ptr) == 0
+}
+
+// MoveInto moves the item, with its children, to be the child of parent at
+// index pos.
+func (t TreeItem) MoveInto(parent TreeItem, pos int) bool {
+	defer t.tree.Redraw()
+	return C.go_fltk_Tree_Item_move_into(t.ptr, parent.ptr, C.int(pos)) == 0
+}
+
+// MoveChild moves the child at index from to index to.
+func (t TreeItem) MoveChild(to, from int) bool {
+	defer t.tree.Redraw()
+	return C.go_fltk_Tree_Item_move(t.ptr, C.int(to), C.int(from)) == 0
+}
+
 type TreeItemDrawMode uint
 
 var (
@@ -85,3 +572,18 @@ var (
 func (t *Tree) SetSelectMode(selectMode TreeSelect) {
 	C.go_fltk_Tree_set_select_mode((*C.Fl_Tree)(t.ptr()), C.int(selectMode))
 }
+
+// TreeReselectMode tells whether clicking a selected item again runs the
+// callback.
+type TreeReselectMode int
+
+var (
+	TreeSelectableOnce   = TreeReselectMode(C.go_FL_TREE_SELECTABLE_ONCE)
+	TreeSelectableAlways = TreeReselectMode(C.go_FL_TREE_SELECTABLE_ALWAYS)
+)
+
+// SetItemReselectMode sets whether clicking a selected item again runs the
+// callback with TreeReasonReselected. It does not by default.
+func (t *Tree) SetItemReselectMode(mode TreeReselectMode) {
+	C.go_fltk_Tree_set_item_reselect_mode((*C.Fl_Tree)(t.ptr()), C.int(mode))
+}
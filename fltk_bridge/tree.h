@@ -8,6 +8,7 @@ extern "C" {
   typedef struct GTree GTree;
   typedef struct Fl_Tree_Item Fl_Tree_Item;
   typedef struct Fl_Widget Fl_Widget;
+  typedef struct Fl_Image Fl_Image;
 
   extern GTree* go_fltk_new_Tree(int x, int y, int w, int h, const char* text);
 
@@ -17,7 +18,83 @@ extern "C" {
   extern void go_fltk_Tree_clear(Fl_Tree *tree);
   extern void go_fltk_Tree_clear_children(Fl_Tree* tree, Fl_Tree_Item* item);  
 
+  extern Fl_Tree_Item* go_fltk_Tree_add_child(Fl_Tree* tree, Fl_Tree_Item* parent, const char* name);
+  extern Fl_Tree_Item* go_fltk_Tree_insert(Fl_Tree* tree, Fl_Tree_Item* parent, const char* name, int pos);
+  extern Fl_Tree_Item* go_fltk_Tree_insert_above(Fl_Tree* tree, Fl_Tree_Item* above, const char* name);
+
+  extern Fl_Tree_Item* go_fltk_Tree_root(Fl_Tree* tree);
+  extern Fl_Tree_Item* go_fltk_Tree_first(Fl_Tree* tree);
+  extern Fl_Tree_Item* go_fltk_Tree_last(Fl_Tree* tree);
+  extern Fl_Tree_Item* go_fltk_Tree_next(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_prev(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_first_selected_item(Fl_Tree* tree);
+  extern Fl_Tree_Item* go_fltk_Tree_last_selected_item(Fl_Tree* tree);
+  extern Fl_Tree_Item* go_fltk_Tree_next_selected_item(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_find_item(Fl_Tree* tree, const char* path);
+  extern int go_fltk_Tree_item_pathname(Fl_Tree* tree, char* pathname, int len, Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_item_clicked(Fl_Tree* tree);
+
+  extern int go_fltk_Tree_open(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_close(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_select(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_deselect(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_select_only(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_select_all(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern int go_fltk_Tree_deselect_all(Fl_Tree* tree, Fl_Tree_Item* item, int docallback);
+  extern void go_fltk_Tree_set_item_focus(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_get_item_focus(Fl_Tree* tree);
+  extern int go_fltk_Tree_displayed(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern void go_fltk_Tree_show_item(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern void go_fltk_Tree_show_item_top(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern void go_fltk_Tree_show_item_middle(Fl_Tree* tree, Fl_Tree_Item* item);
+  extern void go_fltk_Tree_show_item_bottom(Fl_Tree* tree, Fl_Tree_Item* item);
+
+  extern int go_fltk_Tree_item_labelfont(Fl_Tree* tree);
+  extern void go_fltk_Tree_set_item_labelfont(Fl_Tree* tree, int font);
+  extern int go_fltk_Tree_item_labelsize(Fl_Tree* tree);
+  extern void go_fltk_Tree_set_item_labelsize(Fl_Tree* tree, int size);
+  extern unsigned int go_fltk_Tree_item_labelfgcolor(Fl_Tree* tree);
+  extern void go_fltk_Tree_set_item_labelfgcolor(Fl_Tree* tree, unsigned int color);
+  extern unsigned int go_fltk_Tree_item_labelbgcolor(Fl_Tree* tree);
+  extern void go_fltk_Tree_set_item_labelbgcolor(Fl_Tree* tree, unsigned int color);
+  extern void go_fltk_Tree_set_connectorcolor(Fl_Tree* tree, unsigned int color);
+  extern void go_fltk_Tree_set_showcollapse(Fl_Tree* tree, int show);
+  extern void go_fltk_Tree_set_root_label(Fl_Tree* tree, const char* label);
+
+  extern Fl_Tree_Item* go_fltk_Tree_callback_item(Fl_Tree* tree);
+  extern int go_fltk_Tree_callback_reason(Fl_Tree* tree);
+
   extern void go_fltk_Tree_Item_set_widget(Fl_Tree_Item* item, Fl_Widget* widget);
+  extern const char* go_fltk_Tree_Item_label(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_set_label(Fl_Tree_Item* item, const char* label);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_parent(Fl_Tree_Item* item);
+  extern int go_fltk_Tree_Item_children(Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_child(Fl_Tree_Item* item, int index);
+  extern int go_fltk_Tree_Item_find_child(Fl_Tree_Item* item, const char* name);
+  extern int go_fltk_Tree_Item_index_of(Fl_Tree_Item* item, Fl_Tree_Item* child);
+  extern int go_fltk_Tree_Item_depth(Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_next(Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_prev(Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_next_sibling(Fl_Tree_Item* item);
+  extern Fl_Tree_Item* go_fltk_Tree_Item_prev_sibling(Fl_Tree_Item* item);
+  extern int go_fltk_Tree_Item_is_root(Fl_Tree_Item* item);
+  extern int go_fltk_Tree_Item_is_open(Fl_Tree_Item* item);
+  extern int go_fltk_Tree_Item_is_selected(Fl_Tree_Item* item);
+  extern int go_fltk_Tree_Item_is_active(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_activate(Fl_Tree_Item* item, int active);
+  extern void go_fltk_Tree_Item_set_usericon(Fl_Tree_Item* item, Fl_Image* image);
+  extern int go_fltk_Tree_Item_labelfont(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_set_labelfont(Fl_Tree_Item* item, int font);
+  extern int go_fltk_Tree_Item_labelsize(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_set_labelsize(Fl_Tree_Item* item, int size);
+  extern unsigned int go_fltk_Tree_Item_labelfgcolor(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_set_labelfgcolor(Fl_Tree_Item* item, unsigned int color);
+  extern unsigned int go_fltk_Tree_Item_labelbgcolor(Fl_Tree_Item* item);
+  extern void go_fltk_Tree_Item_set_labelbgcolor(Fl_Tree_Item* item, unsigned int color);
+  extern int go_fltk_Tree_Item_move_above(Fl_Tree_Item* item, Fl_Tree_Item* other);
+  extern int go_fltk_Tree_Item_move_below(Fl_Tree_Item* item, Fl_Tree_Item* other);
+  extern int go_fltk_Tree_Item_move_into(Fl_Tree_Item* item, Fl_Tree_Item* parent, int pos);
+  extern int go_fltk_Tree_Item_move(Fl_Tree_Item* item, int to, int from);
 
   extern const unsigned int go_FL_TREE_ITEM_DRAW_DEFAULT;
   extern const unsigned int go_FL_TREE_ITEM_DRAW_LABEL_AND_WIDGET;
@@ -35,6 +112,18 @@ extern "C" {
   extern const int go_FL_TREE_SELECT_SINGLE_DRAGGABLE;
   extern void go_fltk_Tree_set_select_mode(Fl_Tree* tree, int selectMode);
 
+  extern const int go_FL_TREE_SELECTABLE_ONCE;
+  extern const int go_FL_TREE_SELECTABLE_ALWAYS;
+  extern void go_fltk_Tree_set_item_reselect_mode(Fl_Tree* tree, int mode);
+
+  extern const int go_FL_TREE_REASON_NONE;
+  extern const int go_FL_TREE_REASON_SELECTED;
+  extern const int go_FL_TREE_REASON_DESELECTED;
+  extern const int go_FL_TREE_REASON_RESELECTED;
+  extern const int go_FL_TREE_REASON_OPENED;
+  extern const int go_FL_TREE_REASON_CLOSED;
+  extern const int go_FL_TREE_REASON_DRAGGED;
+
 #ifdef __cplusplus
 }
 #endif
@@ -0,0 +1,279 @@
+package fltk_bridge
+
+/*
+#include "tree.h"
+*/
+import "C"
+import "unsafe"
+
+// TreeModel supplies the nodes shown by a TreeView. Nodes are identified by
+// strings chosen by the model, the root by "".
+type TreeModel interface {
+	// Children returns the children of node id in display order.
+	Children(id string) []string
+	Label(id string) string
+	// HasChildren reports whether node id has children without listing
+	// them, so that the view can show it as expandable cheaply.
+	HasChildren(id string) bool
+	// Icon returns the icon of node id, or nil.
+	Icon(id string) Image
+}
+
+// TreeModelWatcher is implemented by models that report changes. Watch
+// arranges for changed to be called with the id of every node whose label,
+// icon or children changed, on the UI thread, until stop is called.
+type TreeModelWatcher interface {
+	Watch(changed func(id string)) (stop func())
+}
+
+// TreeView is a Tree showing the nodes of a TreeModel. Only the children of
+// open nodes are turned into tree items: a node's children are read from
+// the model when it is opened and dropped again when it is closed, so that
+// models with very many nodes stay cheap to show.
+//
+// The view uses the tree's callback; use SetNodeCallback instead of
+// SetCallback and SetItemCallback. It stops watching the model when it is
+// deleted, also when a parent deletes it.
+type TreeView struct {
+	Tree
+	model TreeModel
+	stop  func()
+	// ids and items map the items for loaded nodes to their ids and back.
+	// Placeholder items, which stand for the children of closed nodes, are
+	// in neither.
+	ids    map[TreeItem]string
+	items  map[string]TreeItem
+	onNode func(id string, reason TreeReason)
+}
+
+func NewTreeView(x, y, w, h int, model TreeModel) *TreeView {
+	v := &TreeView{}
+	initWidget(v, unsafe.Pointer(C.go_fltk_new_Tree(C.int(x), C.int(y), C.int(w), C.int(h), nil)))
+	var deletionHandlerId uintptr
+	deletionHandlerId = v.addDeletionHandler(func() {
+		v.unwatch()
+		v.ids, v.items = nil, nil
+		globalCallbackMap.unregister(deletionHandlerId)
+	})
+	v.SetShowRoot(false)
+	v.SetItemCallback(v.onItem)
+	v.SetModel(model)
+	return v
+}
+
+// unwatch stops watching the model, if it was.
+func (v *TreeView) unwatch() {
+	if v.stop != nil {
+		v.stop()
+		v.stop = nil
+	}
+}
+
+func (v *TreeView) Model() TreeModel { return v.model }
+
+// SetModel shows model, with all nodes closed.
+func (v *TreeView) SetModel(model TreeModel) {
+	v.unwatch()
+	v.model = model
+	root := v.Root()
+	v.ClearChildren(root)
+	v.ids = map[TreeItem]string{root: ""}
+	v.items = map[string]TreeItem{"": root}
+	v.SetRootLabel(model.Label(""))
+	v.load(root, "")
+	if watcher, ok := model.(TreeModelWatcher); ok {
+		v.stop = watcher.Watch(v.Refresh)
+	}
+	v.Redraw()
+}
+
+// SetNodeCallback sets the function called with the node that was
+// selected, deselected, reselected, opened, closed or dragged and why.
+func (v *TreeView) SetNodeCallback(handler func(id string, reason TreeReason)) {
+	v.onNode = handler
+}
+
+// NodeID returns the id of the node shown by item.
+func (v *TreeView) NodeID(item TreeItem) (string, bool) {
+	id, ok := v.ids[item]
+	return id, ok
+}
+
+// NodeItem returns the item showing node id. Only nodes whose parents are
+// all open have one.
+func (v *TreeView) NodeItem(id string) (TreeItem, bool) {
+	item, ok := v.items[id]
+	return item, ok
+}
+
+// SelectedNodes returns the ids of the selected nodes in top-down order.
+func (v *TreeView) SelectedNodes() []string {
+	var ids []string
+	for _, item := range v.SelectedItems() {
+		if id, ok := v.ids[item]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// OpenNode opens the loaded node id, reading its children from the model,
+// and reports whether it was closed.
+func (v *TreeView) OpenNode(id string) bool {
+	item, ok := v.items[id]
+	if !ok || !v.Open(item, false) {
+		return false
+	}
+	v.load(item, id)
+	return true
+}
+
+// CloseNode closes the loaded node id, dropping the items of its
+// descendants, and reports whether it was open.
+func (v *TreeView) CloseNode(id string) bool {
+	item, ok := v.items[id]
+	if !ok || !v.Close(item, false) {
+		return false
+	}
+	v.release(item, id)
+	return true
+}
+
+// Refresh reads node id from the model again: its label and icon and, if it
+// is open, its children, keeping the open and selected ones that remain
+// open and selected. Nodes that are not loaded are read when they are
+// shown and need no refresh.
+func (v *TreeView) Refresh(id string) {
+	item, ok := v.items[id]
+	if !ok {
+		return
+	}
+	if id == "" {
+		v.SetRootLabel(v.model.Label(""))
+	} else {
+		item.SetLabel(v.model.Label(id))
+		v.setIcon(item, id)
+	}
+	if !item.IsOpen() {
+		v.release(item, id)
+		return
+	}
+	open, selected := map[string]bool{}, map[string]bool{}
+	v.collectState(item, open, selected)
+	v.load(item, id)
+	v.restoreState(item, open, selected)
+	v.Redraw()
+}
+
+func (v *TreeView) onItem(item TreeItem, reason TreeReason) {
+	id, ok := v.ids[item]
+	if !ok {
+		return
+	}
+	switch reason {
+	case TreeReasonOpened:
+		v.load(item, id)
+	case TreeReasonClosed:
+		v.release(item, id)
+	}
+	if v.onNode != nil {
+		v.onNode(id, reason)
+	}
+}
+
+// load replaces the children of item with items for the children of node
+// id, all closed.
+func (v *TreeView) load(item TreeItem, id string) {
+	v.clearNode(item)
+	for _, child := range v.model.Children(id) {
+		childItem := v.AddChild(item, v.model.Label(child))
+		v.ids[childItem], v.items[child] = child, childItem
+		v.setIcon(childItem, child)
+		v.release(childItem, child)
+		v.Close(childItem, false)
+	}
+}
+
+// release drops the children of item and, if node id has children, adds a
+// placeholder so that the item can be opened.
+func (v *TreeView) release(item TreeItem, id string) {
+	v.clearNode(item)
+	if v.model.HasChildren(id) {
+		v.AddChild(item, "")
+	}
+}
+
+// clearNode removes the children of item and forgets their nodes.
+func (v *TreeView) clearNode(item TreeItem) {
+	if !item.HasChildren() {
+		return
+	}
+	// The tree does not notice its focus item being removed along with
+	// its parent.
+	for focus := v.ItemFocus(); focus.IsValid(); focus = focus.Parent() {
+		if focus.Parent() == item {
+			v.SetItemFocus(item)
+			break
+		}
+	}
+	v.forgetNodes(item)
+	v.ClearChildren(item)
+}
+
+func (v *TreeView) forgetNodes(item TreeItem) {
+	for i := 0; i < item.Children(); i++ {
+		child := item.Child(i)
+		if id, ok := v.ids[child]; ok {
+			delete(v.ids, child)
+			delete(v.items, id)
+			v.forgetNodes(child)
+		}
+	}
+}
+
+func (v *TreeView) setIcon(item TreeItem, id string) {
+	if icon := v.model.Icon(id); icon != nil {
+		item.SetUserIcon(icon)
+	} else {
+		item.ClearUserIcon()
+	}
+}
+
+// collectState records which loaded descendants of item are open and
+// selected.
+func (v *TreeView) collectState(item TreeItem, open, selected map[string]bool) {
+	for i := 0; i < item.Children(); i++ {
+		child := item.Child(i)
+		id, ok := v.ids[child]
+		if !ok {
+			continue
+		}
+		if child.IsSelected() {
+			selected[id] = true
+		}
+		if child.IsOpen() {
+			open[id] = true
+			v.collectState(child, open, selected)
+		}
+	}
+}
+
+// restoreState reopens and reselects the children of item, and their
+// descendants, that collectState recorded.
+func (v *TreeView) restoreState(item TreeItem, open, selected map[string]bool) {
+	for i := 0; i < item.Children(); i++ {
+		child := item.Child(i)
+		id, ok := v.ids[child]
+		if !ok {
+			continue
+		}
+		if selected[id] {
+			v.Select(child, false)
+		}
+		if open[id] && v.model.HasChildren(id) {
+			v.Open(child, false)
+			v.load(child, id)
+			v.restoreState(child, open, selected)
+		}
+	}
+}
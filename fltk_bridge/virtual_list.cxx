@@ -0,0 +1,230 @@
+#include "virtual_list.h"
+
+#include <vector>
+
+#include <FL/Fl_Browser_.H>
+
+#include "event_handler.h"
+
+#include "_cgo_export.h"
+
+
+// GVirtualList is a browser whose items are only the numbers 0 to count-1:
+// their heights and drawing come from Go, and only which of them are
+// selected is kept here. Items are passed to Fl_Browser_ as index+1, so that
+// no item is a null pointer.
+class GVirtualList : public EventHandler<Fl_Browser_> {
+public:
+  GVirtualList(int x, int y, int w, int h)
+    : EventHandler<Fl_Browser_>(x, y, w, h) {}
+
+  void set_count(int count) {
+    deselect();
+    m_count = count;
+    m_selected.assign(count, false);
+    invalidate_heights();
+    new_list();
+  }
+  void set_item_height(int height) {
+    m_itemHeight = height;
+    new_list();
+  }
+  void set_variable_height(int on) {
+    m_variableHeight = on != 0;
+    invalidate_heights();
+    new_list();
+  }
+  // invalidate_heights forgets the heights asked of Go, which are asked
+  // again when next needed.
+  void invalidate_heights() {
+    m_heights.clear();
+    m_fullHeight = -1;
+  }
+  void set_item_width(int width) {
+    m_itemWidth = width;
+    redraw();
+  }
+  int selection_index() const {
+    return index(selection());
+  }
+  int top_index() const {
+    return index(top());
+  }
+  int displayed_index(int i) const {
+    return valid(i) ? displayed(item(i)) : 0;
+  }
+  void redraw_index(int i) {
+    if (valid(i)) {
+      redraw_line(item(i));
+    }
+  }
+  int index_at(int y) {
+    return index(find_item(y));
+  }
+  int selected_index(int i) const {
+    return valid(i) && m_selected[i];
+  }
+  int selected_indexes(int* indexes, int max) const {
+    int n = 0;
+    for (int i = 0; i < m_count; i++) {
+      if (m_selected[i]) {
+        if (n < max) {
+          indexes[n] = i;
+        }
+        n++;
+      }
+    }
+    return n;
+  }
+  bool valid(int i) const {
+    return i >= 0 && i < m_count;
+  }
+  static void* item(int i) {
+    return (void*)(intptr_t)(i + 1);
+  }
+  static int index(void* item) {
+    return item ? (int)((intptr_t)item - 1) : -1;
+  }
+
+protected:
+  void* item_first() const override {
+    return m_count > 0 ? item(0) : nullptr;
+  }
+  void* item_next(void* p) const override {
+    int i = index(p) + 1;
+    return i < m_count ? item(i) : nullptr;
+  }
+  void* item_prev(void* p) const override {
+    int i = index(p) - 1;
+    return i >= 0 ? item(i) : nullptr;
+  }
+  void* item_last() const override {
+    return m_count > 0 ? item(m_count - 1) : nullptr;
+  }
+  void* item_at(int i) const override {
+    return valid(i) ? item(i) : nullptr;
+  }
+  int item_height(void* p) const override {
+    if (m_variableHeight) {
+      return cached_height(index(p));
+    }
+    return m_itemHeight;
+  }
+  int item_width(void*) const override {
+    return m_itemWidth;
+  }
+  // Without a Go height function every item is as high as the first, so the
+  // browser need not visit them all; with one, the heights are summed once
+  // and kept until they are invalidated.
+  int full_height() const override {
+    if (m_variableHeight) {
+      if (m_fullHeight < 0) {
+        m_fullHeight = 0;
+        for (int i = 0; i < m_count; i++) {
+          m_fullHeight += cached_height(i);
+        }
+      }
+      return m_fullHeight;
+    }
+    return m_count * m_itemHeight;
+  }
+  int incr_height() const override {
+    return m_itemHeight;
+  }
+  void item_draw(void* p, int x, int y, int w, int h) const override {
+    _go_virtualListDrawItem((uintptr_t)this, index(p), x, y, w, h, item_selected(p));
+  }
+  void item_select(void* p, int val) override {
+    m_selected[index(p)] = val != 0;
+  }
+  int item_selected(void* p) const override {
+    return m_selected[index(p)];
+  }
+
+private:
+  // cached_height returns the height of item i, asking Go only the first
+  // time since the heights were invalidated.
+  int cached_height(int i) const {
+    if (m_heights.size() != (size_t)m_count) {
+      m_heights.assign(m_count, -1);
+    }
+    if (m_heights[i] < 0) {
+      m_heights[i] = _go_virtualListItemHeight((uintptr_t)this, i);
+    }
+    return m_heights[i];
+  }
+
+  int m_count = 0;
+  int m_itemHeight = 20;
+  int m_itemWidth = 0;
+  bool m_variableHeight = false;
+  std::vector<bool> m_selected;
+  // m_heights holds the heights from Go, -1 where not asked yet, and
+  // m_fullHeight their sum, -1 until it is needed.
+  mutable std::vector<int> m_heights;
+  mutable int m_fullHeight = -1;
+};
+
+GVirtualList* go_fltk_new_VirtualList(int x, int y, int w, int h) {
+  return new GVirtualList(x, y, w, h);
+}
+void go_fltk_VirtualList_set_count(GVirtualList* l, int count) {
+  l->set_count(count);
+}
+void go_fltk_VirtualList_set_item_height(GVirtualList* l, int height) {
+  l->set_item_height(height);
+}
+void go_fltk_VirtualList_set_variable_height(GVirtualList* l, int on) {
+  l->set_variable_height(on);
+}
+void go_fltk_VirtualList_invalidate_heights(GVirtualList* l) {
+  l->invalidate_heights();
+  l->redraw();
+}
+void go_fltk_VirtualList_set_item_width(GVirtualList* l, int width) {
+  l->set_item_width(width);
+}
+void go_fltk_VirtualList_set_type(GVirtualList* l, int type) {
+  l->deselect();
+  l->type(type);
+}
+int go_fltk_VirtualList_value(GVirtualList* l) {
+  return l->selection_index();
+}
+int go_fltk_VirtualList_select(GVirtualList* l, int index, int val, int docallbacks) {
+  return l->valid(index) ? l->select(GVirtualList::item(index), val, docallbacks) : 0;
+}
+int go_fltk_VirtualList_select_only(GVirtualList* l, int index, int docallbacks) {
+  return l->valid(index) ? l->select_only(GVirtualList::item(index), docallbacks) : 0;
+}
+int go_fltk_VirtualList_deselect(GVirtualList* l, int docallbacks) {
+  return l->deselect(docallbacks);
+}
+int go_fltk_VirtualList_selected(GVirtualList* l, int index) {
+  return l->selected_index(index);
+}
+int go_fltk_VirtualList_selected_items(GVirtualList* l, int* indexes, int max) {
+  return l->selected_indexes(indexes, max);
+}
+int go_fltk_VirtualList_top(GVirtualList* l) {
+  return l->top_index();
+}
+void go_fltk_VirtualList_display(GVirtualList* l, int index) {
+  if (l->valid(index)) {
+    l->display(GVirtualList::item(index));
+  }
+}
+int go_fltk_VirtualList_displayed(GVirtualList* l, int index) {
+  return l->displayed_index(index);
+}
+void go_fltk_VirtualList_redraw_item(GVirtualList* l, int index) {
+  l->redraw_index(index);
+}
+int go_fltk_VirtualList_item_at(GVirtualList* l, int y) {
+  return l->index_at(y);
+}
+
+const int go_FL_NORMAL_BROWSER = FL_NORMAL_BROWSER;
+const int go_FL_SELECT_BROWSER = FL_SELECT_BROWSER;
+const int go_FL_HOLD_BROWSER = FL_HOLD_BROWSER;
+const int go_FL_MULTI_BROWSER = FL_MULTI_BROWSER;
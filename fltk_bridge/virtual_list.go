@@ -0,0 +1,268 @@
+package fltk_bridge
+
+/*
+#include "virtual_list.h"
+*/
+import "C"
+import (
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// typeAheadTimeout is how long after the last key typed into a VirtualList
+// the next one starts a new search rather than extending the prefix.
+const typeAheadTimeout = time.Second
+
+type VirtualListType int
+
+var (
+	// VirtualListNormal items cannot be selected.
+	VirtualListNormal = VirtualListType(C.go_FL_NORMAL_BROWSER)
+	// VirtualListSelect items are selected while the mouse is held on
+	// them, as in a SelectBrowser.
+	VirtualListSelect = VirtualListType(C.go_FL_SELECT_BROWSER)
+	// VirtualListHold keeps one selected item, as a HoldBrowser does.
+	VirtualListHold = VirtualListType(C.go_FL_HOLD_BROWSER)
+	// VirtualListMulti selects any number of items, as a MultiBrowser does.
+	VirtualListMulti = VirtualListType(C.go_FL_MULTI_BROWSER)
+)
+
+// VirtualList is a list of items that exist only as numbers, from 0 to
+// Count()-1: the list asks Go for the height of an item and to draw it when
+// it is shown, so that it holds millions of items in the memory of a few.
+// Typing while the list has the focus selects the next item whose text,
+// as returned by the text function, starts with what was typed.
+//
+// Without a height function all items are as high as SetItemHeight says,
+// which keeps scrolling fast however many there are; with one, the list
+// asks for the height of every item once to find its size, and again only
+// after InvalidateHeights.
+//
+// The list uses its own event handler; replacing it stops type-ahead
+// search.
+type VirtualList struct {
+	Group
+	count  int
+	height func(index int) int
+	draw   func(index, x, y, w, h int, selected bool)
+	text   func(index int) string
+	// typed is the prefix typed so far and typedAt when its last key was.
+	typed   string
+	typedAt time.Time
+}
+
+// virtualLists maps the address of the C++ list to the Go one, for the
+// item callbacks.
+var virtualLists = map[uintptr]*VirtualList{}
+
+func NewVirtualList(x, y, w, h int) *VirtualList {
+	l := &VirtualList{}
+	initWidget(l, unsafe.Pointer(C.go_fltk_new_VirtualList(C.int(x), C.int(y), C.int(w), C.int(h))))
+	list := uintptr(unsafe.Pointer(l.ptr()))
+	virtualLists[list] = l
+	var deletionHandlerId uintptr
+	deletionHandlerId = l.addDeletionHandler(func() {
+		delete(virtualLists, list)
+		globalCallbackMap.unregister(deletionHandlerId)
+	})
+	l.SetType(VirtualListHold)
+	l.SetEventHandler(l.onEvent)
+	return l
+}
+
+func (l *VirtualList) cList() *C.GVirtualList {
+	return (*C.GVirtualList)(l.ptr())
+}
+
+// SetCount sets the number of items, deselecting all and scrolling to the
+// top.
+func (l *VirtualList) SetCount(count int) {
+	l.count = count
+	C.go_fltk_VirtualList_set_count(l.cList(), C.int(count))
+	l.Redraw()
+}
+func (l *VirtualList) Count() int { return l.count }
+
+// SetItemHeight sets the height of all items when there is no height
+// function. It is 20 by default.
+func (l *VirtualList) SetItemHeight(height int) {
+	C.go_fltk_VirtualList_set_item_height(l.cList(), C.int(height))
+	l.Redraw()
+}
+
+// SetItemHeightFunc sets the function returning the height of each item,
+// or, if it is nil, makes all items as high as SetItemHeight says.
+func (l *VirtualList) SetItemHeightFunc(height func(index int) int) {
+	l.height = height
+	C.go_fltk_VirtualList_set_variable_height(l.cList(), cBool(height != nil))
+	l.Redraw()
+}
+
+// InvalidateHeights makes the list ask the height function again for the
+// height of every item, after the heights it returns changed.
+func (l *VirtualList) InvalidateHeights() {
+	C.go_fltk_VirtualList_invalidate_heights(l.cList())
+}
+
+// SetItemWidth sets the width of the items, which scroll horizontally if it
+// is wider than the list. It is 0, fitting the list, by default.
+func (l *VirtualList) SetItemWidth(width int) {
+	C.go_fltk_VirtualList_set_item_width(l.cList(), C.int(width))
+}
+
+// SetDrawItemFunc sets the function that draws item index in the box x, y,
+// w, h, over the selection color if it is selected. Without one, the list
+// draws the text of the item.
+func (l *VirtualList) SetDrawItemFunc(draw func(index, x, y, w, h int, selected bool)) {
+	l.draw = draw
+	l.Redraw()
+}
+
+// SetTextFunc sets the function returning the text of an item, searched by
+// type-ahead and drawn if there is no draw function.
+func (l *VirtualList) SetTextFunc(text func(index int) string) {
+	l.text = text
+	l.Redraw()
+}
+
+// SetType sets how items are selected, deselecting all. It is
+// VirtualListHold by default.
+func (l *VirtualList) SetType(listType VirtualListType) {
+	C.go_fltk_VirtualList_set_type(l.cList(), C.int(listType))
+}
+
+// Value returns the selected item, or for a VirtualListMulti the item with
+// the keyboard focus, or -1.
+func (l *VirtualList) Value() int {
+	return int(C.go_fltk_VirtualList_value(l.cList()))
+}
+
+// SetValue selects only item index and scrolls it into view.
+func (l *VirtualList) SetValue(index int) {
+	C.go_fltk_VirtualList_select_only(l.cList(), C.int(index), 0)
+	C.go_fltk_VirtualList_display(l.cList(), C.int(index))
+}
+
+// SetSelected selects or deselects item index and reports whether that
+// changed it.
+func (l *VirtualList) SetSelected(index int, selected bool) bool {
+	return C.go_fltk_VirtualList_select(l.cList(), C.int(index), cBool(selected), 0) != 0
+}
+func (l *VirtualList) IsSelected(index int) bool {
+	return C.go_fltk_VirtualList_selected(l.cList(), C.int(index)) != 0
+}
+
+// DeselectAll deselects all items.
+func (l *VirtualList) DeselectAll() {
+	C.go_fltk_VirtualList_deselect(l.cList(), 0)
+}
+
+// SelectedItems returns the selected items in order.
+func (l *VirtualList) SelectedItems() []int {
+	n := int(C.go_fltk_VirtualList_selected_items(l.cList(), nil, 0))
+	if n == 0 {
+		return nil
+	}
+	indexes := make([]C.int, n)
+	C.go_fltk_VirtualList_selected_items(l.cList(), &indexes[0], C.int(n))
+	items := make([]int, n)
+	for i, index := range indexes {
+		items[i] = int(index)
+	}
+	return items
+}
+
+// TopItem returns the item shown at the top, or -1 if there are none.
+func (l *VirtualList) TopItem() int {
+	return int(C.go_fltk_VirtualList_top(l.cList()))
+}
+
+// ShowItem scrolls item index into view.
+func (l *VirtualList) ShowItem(index int) {
+	C.go_fltk_VirtualList_display(l.cList(), C.int(index))
+}
+
+// Displayed reports whether item index is in view.
+func (l *VirtualList) Displayed(index int) bool {
+	return C.go_fltk_VirtualList_displayed(l.cList(), C.int(index)) != 0
+}
+
+// RedrawItem redraws item index after what it shows changed.
+func (l *VirtualList) RedrawItem(index int) {
+	C.go_fltk_VirtualList_redraw_item(l.cList(), C.int(index))
+}
+
+// ItemAt returns the item at window y coordinate y, or -1.
+func (l *VirtualList) ItemAt(y int) int {
+	return int(C.go_fltk_VirtualList_item_at(l.cList(), C.int(y)))
+}
+
+func (l *VirtualList) onEvent(event Event) bool {
+	if event != KEYDOWN || l.text == nil || EventState()&(CTRL|ALT|META) != 0 {
+		return false
+	}
+	text := EventText()
+	if text == "" || text[0] < ' ' || text[0] == 0x7f {
+		return false
+	}
+	if i := l.typeAhead(text, l.Value(), time.Now()); i >= 0 {
+		C.go_fltk_VirtualList_select_only(l.cList(), C.int(i), 1)
+		C.go_fltk_VirtualList_display(l.cList(), C.int(i))
+	}
+	return true
+}
+
+// typeAhead adds text, typed at now, to the prefix searched for, starting a
+// new prefix if the last key was typed more than typeAheadTimeout before,
+// and returns the item it selects, or -1. current is the selected item.
+func (l *VirtualList) typeAhead(text string, current int, now time.Time) int {
+	// a new search starts after the current item, a longer prefix at it
+	start := current
+	if now.Sub(l.typedAt) > typeAheadTimeout {
+		l.typed, start = "", start+1
+	}
+	l.typed += strings.ToLower(text)
+	l.typedAt = now
+	return l.find(l.typed, max(start, 0))
+}
+
+// find returns the first item from start on, wrapping around, whose text
+// starts with prefix in any case, or -1.
+func (l *VirtualList) find(prefix string, start int) int {
+	for n := 0; n < l.count; n++ {
+		i := (start + n) % l.count
+		if strings.HasPrefix(strings.ToLower(l.text(i)), prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+//export _go_virtualListItemHeight
+func _go_virtualListItemHeight(list C.uintptr_t, index C.int) C.int {
+	if l, ok := virtualLists[uintptr(list)]; ok && l.height != nil {
+		return C.int(l.height(int(index)))
+	}
+	return 0
+}
+
+//export _go_virtualListDrawItem
+func _go_virtualListDrawItem(list C.uintptr_t, index, x, y, w, h, selected C.int) {
+	l, ok := virtualLists[uintptr(list)]
+	if !ok {
+		return
+	}
+	switch {
+	case l.draw != nil:
+		l.draw(int(index), int(x), int(y), int(w), int(h), selected != 0)
+	case l.text != nil:
+		color := FOREGROUND_COLOR
+		if selected != 0 {
+			color = WHITE
+		}
+		SetDrawFont(HELVETICA, 14)
+		SetDrawColor(color)
+		Draw(l.text(int(index)), int(x)+2, int(y), int(w)-4, int(h), ALIGN_LEFT|ALIGN_CLIP)
+	}
+}
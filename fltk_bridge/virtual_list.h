@@ -0,0 +1,37 @@
+#pragma once
+
+#include <stdint.h>
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+  typedef struct GVirtualList GVirtualList;
+
+  extern GVirtualList* go_fltk_new_VirtualList(int x, int y, int w, int h);
+  extern void go_fltk_VirtualList_set_count(GVirtualList* l, int count);
+  extern void go_fltk_VirtualList_set_item_height(GVirtualList* l, int height);
+  extern void go_fltk_VirtualList_set_variable_height(GVirtualList* l, int on);
+  extern void go_fltk_VirtualList_invalidate_heights(GVirtualList* l);
+  extern void go_fltk_VirtualList_set_item_width(GVirtualList* l, int width);
+  extern void go_fltk_VirtualList_set_type(GVirtualList* l, int type);
+  extern int go_fltk_VirtualList_value(GVirtualList* l);
+  extern int go_fltk_VirtualList_select(GVirtualList* l, int index, int val, int docallbacks);
+  extern int go_fltk_VirtualList_select_only(GVirtualList* l, int index, int docallbacks);
+  extern int go_fltk_VirtualList_deselect(GVirtualList* l, int docallbacks);
+  extern int go_fltk_VirtualList_selected(GVirtualList* l, int index);
+  extern int go_fltk_VirtualList_selected_items(GVirtualList* l, int* indexes, int max);
+  extern int go_fltk_VirtualList_top(GVirtualList* l);
+  extern void go_fltk_VirtualList_display(GVirtualList* l, int index);
+  extern int go_fltk_VirtualList_displayed(GVirtualList* l, int index);
+  extern void go_fltk_VirtualList_redraw_item(GVirtualList* l, int index);
+  extern int go_fltk_VirtualList_item_at(GVirtualList* l, int y);
+
+  extern const int go_FL_NORMAL_BROWSER;
+  extern const int go_FL_SELECT_BROWSER;
+  extern const int go_FL_HOLD_BROWSER;
+  extern const int go_FL_MULTI_BROWSER;
+
+#ifdef __cplusplus
+}
+#endif
@@ -0,0 +1,73 @@
+package fltk_bridge
+
+import (
+	"testing"
+	"time"
+)
+
+var virtualListItems = []string{"Apple", "banana", "Avocado", "cherry", "apricot"}
+
+func newTestVirtualList() *VirtualList {
+	return &VirtualList{
+		count: len(virtualListItems),
+		text:  func(index int) string { return virtualListItems[index] },
+	}
+}
+
+func TestVirtualListFind(t *testing.T) {
+	l := newTestVirtualList()
+	tests := []struct {
+		prefix string
+		start  int
+		want   int
+	}{
+		{"a", 0, 0},
+		{"a", 1, 2},
+		{"a", 3, 4},
+		{"av", 3, 2},
+		{"ap", 1, 4},
+		{"ap", 4, 4},
+		{"b", 2, 1},
+		{"c", 4, 3},
+		{"z", 0, -1},
+		{"", 3, 3},
+	}
+	for _, tt := range tests {
+		if got := l.find(tt.prefix, tt.start); got != tt.want {
+			t.Errorf("find(%q, %d) = %d, want %d", tt.prefix, tt.start, got, tt.want)
+		}
+	}
+
+	empty := &VirtualList{text: func(int) string { return "" }}
+	if got := empty.find("a", 0); got != -1 {
+		t.Errorf("find in an empty list = %d, want -1", got)
+	}
+}
+
+func TestVirtualListTypeAhead(t *testing.T) {
+	l := newTestVirtualList()
+	now := time.Now()
+	steps := []struct {
+		text    string
+		current int
+		after   time.Duration
+		want    int
+	}{
+		// a new search starts after the current item
+		{"a", -1, 0, 0},
+		{"a", 0, typeAheadTimeout + 1, 2},
+		// a longer prefix is searched from the current item
+		{"p", 2, typeAheadTimeout / 2, 4},
+		{"r", 4, typeAheadTimeout / 2, 4},
+		// after the timeout the prefix starts over, wrapping around
+		{"a", 4, typeAheadTimeout + 1, 0},
+		{"x", 0, typeAheadTimeout / 2, -1},
+		{"c", 0, typeAheadTimeout + 1, 3},
+	}
+	for i, step := range steps {
+		now = now.Add(step.after)
+		if got := l.typeAhead(step.text, step.current, now); got != step.want {
+			t.Errorf("step %d: typing %q selects %d, want %d (prefix %q)", i, step.text, got, step.want, l.typed)
+		}
+	}
+}
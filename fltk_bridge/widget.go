@@ -120,6 +120,7 @@ func (w *widget) onDelete() {
 		globalEventHandlerMap.unregister(w.eventHandlerId)
 	}
 	w.eventHandlerId = 0
+	releaseWidgetImages(C.go_fltk_Widget_Tracker_widget(w.tracker))
 	C.go_fltk_Widget_Tracker_delete(w.tracker)
 	w.tracker = nil
 }
@@ -140,9 +141,69 @@ func (w *widget) Destroy() {
 		globalEventHandlerMap.unregister(w.eventHandlerId)
 	}
 	w.eventHandlerId = 0
+	releaseWidgetImages(w.ptr())
 	C.go_fltk_delete_widget(w.ptr())
 }
 
+// imageRole tells apart the images a single widget displays.
+type imageRole int
+
+const (
+	roleImage imageRole = iota
+	roleDeimage
+	roleIcons
+	// roleAnimation is an animation played on the widget as its canvas.
+	roleAnimation
+)
+
+// globalWidgetImages keeps the images shown by each widget alive and counted
+// as used until the widget replaces them or is deleted.
+var globalWidgetImages = make(map[*C.Fl_Widget]map[imageRole][]*image)
+
+// setImages replaces the images w holds for role. New images are retained
+// before the old ones are dropped so that re-setting the same image is safe.
+func (w *widget) setImages(role imageRole, imgs ...*image) {
+	p := w.ptr()
+	for _, img := range imgs {
+		img.retain()
+	}
+	roles := globalWidgetImages[p]
+	old := roles[role]
+	if len(imgs) == 0 {
+		delete(roles, role)
+		if len(roles) == 0 {
+			delete(globalWidgetImages, p)
+		}
+	} else {
+		if roles == nil {
+			roles = make(map[imageRole][]*image)
+			globalWidgetImages[p] = roles
+		}
+		roles[role] = imgs
+	}
+	for _, img := range old {
+		img.drop()
+	}
+}
+
+// addImage holds img for role in addition to the images already held.
+func (w *widget) addImage(role imageRole, img *image) {
+	w.setImages(role, append(globalWidgetImages[w.ptr()][role], img)...)
+}
+
+func releaseWidgetImages(p *C.Fl_Widget) {
+	roles, ok := globalWidgetImages[p]
+	if !ok {
+		return
+	}
+	delete(globalWidgetImages, p)
+	for _, imgs := range roles {
+		for _, img := range imgs {
+			img.drop()
+		}
+	}
+}
+
 func (w *widget) SetBox(box BoxType)           { C.go_fltk_Widget_set_box(w.ptr(), C.int(box)) }
 func (w *widget) SetLabelFont(font Font)       { C.go_fltk_Widget_set_labelfont(w.ptr(), C.int(font)) }
 func (w *widget) SetLabelSize(size int)        { C.go_fltk_Widget_set_labelsize(w.ptr(), C.int(size)) }
@@ -181,11 +242,18 @@ func (w *widget) SetLabel(label string) {
 	defer C.free(unsafe.Pointer(labelStr))
 	C.go_fltk_Widget_set_label(w.ptr(), labelStr)
 }
+
+// SetImage makes the widget display i. The widget keeps i alive until it is
+// given another image or deleted, so i may be dropped or destroyed meanwhile.
 func (w *widget) SetImage(i Image) {
-	C.go_fltk_Widget_set_image(w.ptr(), i.getImage().ptr())
+	img := i.getImage()
+	C.go_fltk_Widget_set_image(w.ptr(), img.ptr())
+	w.setImages(roleImage, img)
 }
 func (w *widget) SetDeimage(i Image) {
-	C.go_fltk_Widget_set_deimage(w.ptr(), i.getImage().ptr())
+	img := i.getImage()
+	C.go_fltk_Widget_set_deimage(w.ptr(), img.ptr())
+	w.setImages(roleDeimage, img)
 }
 func (w *widget) Box() BoxType         { return BoxType(C.go_fltk_Widget_box(w.ptr())) }
 func (w *widget) LabelColor() Color    { return Color(C.go_fltk_Widget_labelcolor(w.ptr())) }
@@ -244,3 +244,36 @@ func TestDestroyingInputChoice(t *testing.T) {
 	win.Show()
 	Run()
 }
+
+// Table uses the same cleanup procedure as TableRow
+func TestDestroyingTable(t *testing.T) {
+	win := NewWindow(400, 400)
+	tb := NewTable(20, 20, 50, 50)
+	tb.SetResizeHandler(func() {})
+	tb.SetDrawCellCallback(nil)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Did not panic")
+		} else if err, ok := r.(error); !ok {
+			t.Errorf("Panicked with not an error: %v", r)
+		} else if !errors.Is(err, ErrDestroyed) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		testWidgetDestroyed("table", tb, t)
+		testGlobalMapsEmpty(t)
+		Unlock()
+	}()
+	tb.SetEventHandler(func(event Event) bool {
+		if event != SHOW {
+			return false
+		}
+		tb.Destroy()
+		Wait()
+		tb.IsSelected(0, 0)
+		panic("Should have panicked")
+	})
+	win.End()
+	Lock()
+	win.Show()
+	Run()
+}
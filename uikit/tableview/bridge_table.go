@@ -1,29 +1,103 @@
 package tableview
 
-// BridgeTable 是对 fltk_bridge “Table/RowTable” 的最小需求抽象
-// 你只需要在 newBridgeTable(...) 里用你项目现有 fltk_bridge/table.go 实现它。
+import "github.com/0xYeah/fltk2go/fltk_bridge"
+
+// BridgeTable 是 TableView 对底层 fltk_bridge 表格的最小需求抽象
 type BridgeTable interface {
 	SetRows(rows int)
+	SetRowHeight(row, h int)
+	IsRowSelected(row int) bool
+	VisibleRows() (top, bottom int) // 当前可见的行范围（含两端）
 	Redraw()
 
 	// 回调：绘制与事件
 	SetDrawCellHandler(fn func(row int, x, y, w, h int))
+	SetStartPageHandler(fn func())         // 每次重绘开始、绘制各行之前调用一次
 	SetEventHandler(fn func(row int) bool) // 返回 true 表示已处理（例如 click 选中）
+
+	// AddContent：把控件型 cell 的容器加入表格的滚动区域
+	AddContent(w fltk_bridge.Widget)
+
+	// Raw：底层 FLTK widget，用于加入父容器
+	Raw() fltk_bridge.Widget
+
+	// Destroy：删除底层表格及其滚动区域中的控件
+	Destroy()
 }
 
-// newBridgeTable：在这里把 fltk_bridge.Table 适配成 BridgeTable
+// newBridgeTable：用单列、整行选择的 fltk_bridge.TableRow 实现 BridgeTable
 func newBridgeTable(x, y, w, h int) (BridgeTable, error) {
-	// TODO(你来对齐): 用你现有 fltk_bridge/table.go 创建 table 实例并返回
-	//
-	// 例如（伪代码）：
-	//   t := fltk_bridge.NewTable(x,y,w,h)
-	//   return &bridgeTableImpl{t: t}, nil
-	//
-	return nil, ErrBridgeNotImplemented
+	t := fltk_bridge.NewTableRow(x, y, w, h)
+	t.SetColumnCount(1)
+	t.SetType(fltk_bridge.SelectSingle)
+	t.DisableColumnHeaders()
+	t.DisableRowHeaders()
+	t.DisallowColumnResizing()
+	t.DisallowRowResizing()
+	b := &bridgeTableImpl{t: t}
+	b.fitColumn()
+	// 表格宽度变化（例如窗口缩放）时，唯一的一列跟着铺满
+	t.SetResizeHandler(b.fitColumn)
+	return b, nil
 }
 
-var ErrBridgeNotImplemented = &bridgeErr{"bridge_table.go: newBridgeTable() not implemented"}
+type bridgeTableImpl struct {
+	t *fltk_bridge.TableRow
 
-type bridgeErr struct{ s string }
+	startPage func()
+}
+
+func (b *bridgeTableImpl) SetRows(rows int)           { b.t.SetRowCount(rows) }
+func (b *bridgeTableImpl) SetRowHeight(row, h int)    { b.t.SetRowHeight(row, h) }
+func (b *bridgeTableImpl) IsRowSelected(row int) bool { return b.t.IsRowSelected(row) }
+func (b *bridgeTableImpl) VisibleRows() (int, int) {
+	top, _, bottom, _ := b.t.VisibleCells()
+	return top, bottom
+}
+func (b *bridgeTableImpl) Redraw()                         { b.t.Redraw() }
+func (b *bridgeTableImpl) AddContent(w fltk_bridge.Widget) { b.t.Add(w) }
+func (b *bridgeTableImpl) Raw() fltk_bridge.Widget         { return b.t }
+func (b *bridgeTableImpl) Destroy()                        { b.t.Destroy() }
 
-func (e *bridgeErr) Error() string { return e.s }
+// fitColumn：列宽 = 表格宽度 - 边框 - 竖直滚动条，避免出现水平滚动条
+func (b *bridgeTableImpl) fitColumn() {
+	sb := b.t.ScrollbarSize()
+	if sb <= 0 {
+		sb = fltk_bridge.ScrollbarSize()
+	}
+	if cw := b.t.W() - 4 - sb; cw > 0 {
+		b.t.SetColumnWidth(0, cw)
+	}
+}
+
+func (b *bridgeTableImpl) SetDrawCellHandler(fn func(row int, x, y, w, h int)) {
+	b.t.SetDrawCellCallback(func(ctx fltk_bridge.TableContext, row, col, x, y, w, h int) {
+		switch ctx {
+		case fltk_bridge.ContextStartPage:
+			if b.startPage != nil {
+				b.startPage()
+			}
+		case fltk_bridge.ContextCell:
+			fn(row, x, y, w, h)
+		}
+	})
+}
+
+// SetStartPageHandler：与 SetDrawCellHandler 共用表格的绘制回调，需先设置绘制回调才会被调用
+func (b *bridgeTableImpl) SetStartPageHandler(fn func()) { b.startPage = fn }
+
+// SetEventHandler：表格回调在按下、松开等多种事件时都会触发，
+// 这里只把左键在单元格上松开（即一次点击）转成 fn(row)
+func (b *bridgeTableImpl) SetEventHandler(fn func(row int) bool) {
+	b.t.SetCallback(func() {
+		if b.t.CallbackContext() != fltk_bridge.ContextCell {
+			return
+		}
+		if fltk_bridge.EventType() != fltk_bridge.RELEASE || fltk_bridge.EventButton() != fltk_bridge.LeftMouse {
+			return
+		}
+		if fn(b.t.CallbackRow()) {
+			b.t.Redraw()
+		}
+	})
+}
@@ -1,22 +1,138 @@
 package tableview
 
+import (
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+	"github.com/0xYeah/fltk2go/uikit/view"
+)
+
+// CellImage：cell 左侧的图标，fltk_bridge 的各种图片（PNG/SVG/RGB 等）都满足
+type CellImage interface {
+	Draw(x, y, w, h int)
+	W() int
+	H() int
+}
+
+// AccessoryType：cell 右侧的附件样式，对应 UITableViewCell.AccessoryType
+type AccessoryType int
+
+const (
+	AccessoryNone                AccessoryType = iota
+	AccessoryDisclosureIndicator               // ">" 箭头，表示可进入下一级
+	AccessoryCheckmark                         // 对勾
+)
+
 // TableViewCell：UITableViewCell 的最小抽象
 type TableViewCell struct {
 	ReuseID string
 
-	// 你未来若要“控件型 cell”（cell 里放 label/button），
-	// 可以在这里挂一个 uikit/view 的容器，如 ContentView *view.View
-	// 但为了不强依赖你当前 uikit/view 的具体 API，这里先留空。
+	// 绘制型 cell 的内容，由 DataSource.CellForRow 设置
+	Text       string
+	DetailText string // 副标题，非空时与 Text 上下两行显示
+	Image      CellImage
+	Accessory  AccessoryType
+
+	// LayoutSubviews：控件型 cell 的 ContentView 尺寸变化后调用，
+	// (x,y,w,h) 是 ContentView 在窗口中的位置，用于按宽度重新排布子控件
+	LayoutSubviews func(x, y, w, h int)
+
+	// 控件型 cell：ContentView 第一次被访问时创建，随 cell 一起复用
+	content     *view.UIView
+	contentRaw  *fltk_bridge.Group
+	contentHost *contentHost
+	hostedBy    *TableView // ContentView 当前所在的 TableView
+
 	row int
 }
 
 func NewCell(reuseID string) *TableViewCell {
-	return &TableViewCell{ReuseID: reuseID}
+	return &TableViewCell{ReuseID: reuseID, row: -1}
+}
+
+// ContentView：控件型 cell 的容器，对应 UITableViewCell.contentView。
+// 通过 AddSubview 加入的子控件坐标相对 cell 左上角；
+// TableView 在绘制该行时把它移到行的位置并显示，行滚出可见范围时隐藏。
+// 子控件随 cell 复用，PrepareForReuse 不会移除它们。
+func (c *TableViewCell) ContentView() *view.UIView {
+	if c.content != nil {
+		return c.content
+	}
+	g := fltk_bridge.NewGroup(0, 0, 0, 0)
+	g.End()
+	// resizable 为空：移动/缩放容器时只平移子控件，不按比例拉伸
+	g.Resizable(nil)
+	g.Hide()
+	c.contentRaw = g
+	c.contentHost = &contentHost{g: g}
+	c.content = &view.UIView{}
+	c.content.BindRaw(g)
+	c.content.BindHost(c.contentHost)
+	return c.content
 }
 
-// PrepareForReuse：复用前清理状态
+// PrepareForReuse：复用前清理状态，避免上一行的内容残留到新行
 func (c *TableViewCell) PrepareForReuse() {
-	// TODO: 你需要的清理逻辑（文本、颜色、回调解绑等）
+	c.Text = ""
+	c.DetailText = ""
+	c.Image = nil
+	c.Accessory = AccessoryNone
+	c.row = -1
 }
 
 func (c *TableViewCell) Row() int { return c.row }
+
+// layoutContent：把 ContentView 放到 (x,y,w,h) 并显示；尺寸变化时调用 LayoutSubviews
+func (c *TableViewCell) layoutContent(x, y, w, h int) {
+	g := c.contentRaw
+	if g == nil {
+		return
+	}
+	moved := g.X() != x || g.Y() != y
+	resized := g.W() != w || g.H() != h
+	if moved || resized {
+		g.Resize(x, y, w, h)
+	}
+	if resized && c.LayoutSubviews != nil {
+		c.LayoutSubviews(x, y, w, h)
+	}
+	if !g.Visible() {
+		g.Show()
+	}
+}
+
+// hideContent：行滚出可见范围或 cell 回收时隐藏 ContentView
+func (c *TableViewCell) hideContent() {
+	if c.contentRaw != nil && c.contentRaw.Visible() {
+		c.contentRaw.Hide()
+	}
+}
+
+// destroyContent：删除 ContentView 容器及其子控件，之后再访问 ContentView 会重新创建
+func (c *TableViewCell) destroyContent() {
+	if c.contentRaw == nil {
+		return
+	}
+	c.contentRaw.Destroy()
+	c.content, c.contentRaw, c.contentHost, c.hostedBy = nil, nil, nil, nil
+}
+
+// contentHost：ContentView 的父容器，加入的子控件按相对容器左上角的坐标平移
+type contentHost struct {
+	g *fltk_bridge.Group
+}
+
+type movable interface {
+	X() int
+	Y() int
+	W() int
+	H() int
+	Resize(x, y, w, h int)
+}
+
+func (h *contentHost) Add(w fltk_bridge.Widget) {
+	if m, ok := w.(movable); ok {
+		m.Resize(h.g.X()+m.X(), h.g.Y()+m.Y(), m.W(), m.H())
+	}
+	h.g.Add(w)
+}
+
+func (h *contentHost) Remove(w fltk_bridge.Widget) { h.g.Remove(w) }
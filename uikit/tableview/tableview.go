@@ -1,6 +1,20 @@
 package tableview
 
+import (
+	"github.com/0xYeah/fltk2go/fltk_bridge"
+	"github.com/0xYeah/fltk2go/uikit/view"
+)
+
+// cell 绘制用的尺寸
+const (
+	cellPadding    = 8
+	accessorySize  = 12
+	cellTextSize   = 14
+	cellDetailSize = 12
+)
+
 type TableView struct {
+	v     view.UIView
 	table BridgeTable
 
 	dataSource DataSource
@@ -10,7 +24,7 @@ type TableView struct {
 
 	// 复用池：reuseID -> cells
 	reusePool map[string][]*TableViewCell
-	// 可见缓存（可选）：row -> cell
+	// 可见缓存：row -> cell，滚出可见范围的 cell 回收到复用池（ContentView 随之隐藏）
 	visible map[int]*TableViewCell
 }
 
@@ -26,17 +40,22 @@ func New(x, y, w, h int) (*TableView, error) {
 		reusePool:        map[string][]*TableViewCell{},
 		visible:          map[int]*TableViewCell{},
 	}
+	tv.v.BindRaw(bt.Raw())
 
 	// 绑定底层回调
 	tv.table.SetDrawCellHandler(tv.onDrawCell)
+	tv.table.SetStartPageHandler(tv.recycleHidden)
 	tv.table.SetEventHandler(tv.onEvent)
 
 	return tv, nil
 }
 
+func (tv *TableView) View() *view.UIView { return &tv.v }
+
 func (tv *TableView) SetDataSource(ds DataSource) { tv.dataSource = ds }
 func (tv *TableView) SetDelegate(d Delegate)      { tv.delegate = d }
 
+// SetDefaultRowHeight：Delegate 未实现或 RowHeight 返回 0 时使用，ReloadData 后生效
 func (tv *TableView) SetDefaultRowHeight(h int) {
 	if h > 0 {
 		tv.defaultRowHeight = h
@@ -54,13 +73,37 @@ func (tv *TableView) Dequeue(reuseID string) *TableViewCell {
 	return NewCell(reuseID)
 }
 
+// Enqueue：回收 cell 到复用池，控件型 cell 的 ContentView 同时隐藏；
+// 没有 ReuseID 的 cell 不进复用池，但 ContentView 同样隐藏，
+// 放进过表格的 ContentView 随表格一起删除
 func (tv *TableView) Enqueue(c *TableViewCell) {
-	if c == nil || c.ReuseID == "" {
+	if c == nil {
+		return
+	}
+	c.hideContent()
+	if c.ReuseID == "" {
 		return
 	}
 	tv.reusePool[c.ReuseID] = append(tv.reusePool[c.ReuseID], c)
 }
 
+// Destroy：删除表格，以及复用池和可见 cell 的 ContentView 容器（含尚未放进表格的）；
+// 之后不能再使用该 TableView
+func (tv *TableView) Destroy() {
+	// 容器先于表格删除：表格删除时会一并删除仍在其中的子控件
+	for _, cell := range tv.visible {
+		cell.destroyContent()
+	}
+	for _, cells := range tv.reusePool {
+		for _, cell := range cells {
+			cell.destroyContent()
+		}
+	}
+	tv.visible = map[int]*TableViewCell{}
+	tv.reusePool = map[string][]*TableViewCell{}
+	tv.table.Destroy()
+}
+
 func (tv *TableView) ReloadData() {
 	if tv.dataSource == nil {
 		tv.table.SetRows(0)
@@ -80,12 +123,25 @@ func (tv *TableView) ReloadData() {
 		rows = 0
 	}
 	tv.table.SetRows(rows)
+	for row := 0; row < rows; row++ {
+		tv.table.SetRowHeight(row, tv.rowHeight(row))
+	}
 	tv.table.Redraw()
 }
 
+// rowHeight：优先 Delegate.RowHeight，返回 0 时用默认行高
+func (tv *TableView) rowHeight(row int) int {
+	if tv.delegate != nil {
+		if h := tv.delegate.RowHeight(tv, row); h > 0 {
+			return h
+		}
+	}
+	return tv.defaultRowHeight
+}
+
 // ============ callbacks ============
 
-// onDrawCell：每行绘制时取 cell 并交给业务层配置
+// onDrawCell：每行绘制时取 cell 并交给业务层配置，然后按 cell 内容绘制
 func (tv *TableView) onDrawCell(row int, x, y, w, h int) {
 	if tv.dataSource == nil {
 		return
@@ -101,15 +157,75 @@ func (tv *TableView) onDrawCell(row int, x, y, w, h int) {
 		tv.visible[row] = cell
 	}
 
-	// 这里是“绘制型 cell”的默认策略：TableViewCell 自身不画，
-	// 交由你桥接层/绘图 API 在 dataSource 的 cell 内完成（比如设置文本、颜色等）
-	//
-	// 如果你想做“UIKit 风格 cell.layoutSubviews + 子控件”，
-	// 后续可以在这里把 cell 的 ContentView frame 设置为 (x,y,w,h) 并 show/hide。
-	_ = x
-	_ = y
-	_ = w
-	_ = h
+	tv.drawCell(cell, tv.table.IsRowSelected(row), x, y, w, h)
+
+	// 控件型 cell：把 ContentView 放进表格并移到本行的位置
+	if cell.contentRaw != nil {
+		if cell.hostedBy != tv {
+			tv.table.AddContent(cell.contentRaw)
+			cell.hostedBy = tv
+		}
+		cell.layoutContent(x, y, w, h)
+	}
+}
+
+// recycleHidden：每次重绘开始时把滚出可见范围的 cell 放回复用池
+func (tv *TableView) recycleHidden() {
+	top, bottom := tv.table.VisibleRows()
+	for row, cell := range tv.visible {
+		if row < top || row > bottom {
+			delete(tv.visible, row)
+			tv.Enqueue(cell)
+		}
+	}
+}
+
+// drawCell：绘制型 cell 的默认样式：左侧图标，中间标题（及副标题），右侧附件，底部分隔线
+func (tv *TableView) drawCell(cell *TableViewCell, selected bool, x, y, w, h int) {
+	bg, fg, detail := fltk_bridge.BACKGROUND2_COLOR, fltk_bridge.FOREGROUND_COLOR, fltk_bridge.DARK3
+	if selected {
+		bg, fg, detail = fltk_bridge.SELECTION_COLOR, fltk_bridge.WHITE, fltk_bridge.LIGHT2
+	}
+
+	fltk_bridge.PushClip(x, y, w, h)
+	defer fltk_bridge.PopClip()
+
+	fltk_bridge.DrawRectfWithColor(x, y, w, h, bg)
+	fltk_bridge.SetDrawColor(fltk_bridge.LIGHT1)
+	fltk_bridge.DrawXyLine(x+cellPadding, y+h-1, x+w)
+
+	left, right := x+cellPadding, x+w-cellPadding
+	if img := cell.Image; img != nil {
+		iw, ih := img.W(), img.H()
+		img.Draw(left, y+(h-ih)/2, iw, ih)
+		left += iw + cellPadding
+	}
+
+	cy := y + h/2
+	switch cell.Accessory {
+	case AccessoryDisclosureIndicator:
+		ax := right - accessorySize/2
+		fltk_bridge.SetDrawColor(detail)
+		fltk_bridge.SetLineStyle(fltk_bridge.SOLID, 2)
+		fltk_bridge.DrawLine2(ax-3, cy-5, ax+2, cy, ax-3, cy+5)
+		fltk_bridge.SetLineStyle(fltk_bridge.SOLID, 0)
+		right -= accessorySize + cellPadding
+	case AccessoryCheckmark:
+		fltk_bridge.DrawCheck(right-accessorySize, cy-accessorySize/2, accessorySize, accessorySize, fg)
+		right -= accessorySize + cellPadding
+	}
+
+	align := fltk_bridge.ALIGN_LEFT | fltk_bridge.ALIGN_CLIP
+	fltk_bridge.SetDrawColor(fg)
+	fltk_bridge.SetDrawFont(fltk_bridge.HELVETICA, cellTextSize)
+	if cell.DetailText == "" {
+		fltk_bridge.Draw(cell.Text, left, y, right-left, h, align)
+		return
+	}
+	fltk_bridge.Draw(cell.Text, left, y, right-left, h/2, align|fltk_bridge.ALIGN_BOTTOM)
+	fltk_bridge.SetDrawColor(detail)
+	fltk_bridge.SetDrawFont(fltk_bridge.HELVETICA, cellDetailSize)
+	fltk_bridge.Draw(cell.DetailText, left, cy, right-left, h-h/2, align|fltk_bridge.ALIGN_TOP)
 }
 
 func (tv *TableView) onEvent(row int) bool {